@@ -8,7 +8,13 @@ import (
 	"net/http"
 	"subscription_service/config"
 	"subscription_service/infrastructure/postgres"
+	"subscription_service/infrastructure/postgres/commands/audit"
+	"subscription_service/infrastructure/postgres/commands/exchangerate"
+	"subscription_service/infrastructure/postgres/commands/idempotency"
+	"subscription_service/infrastructure/postgres/commands/pause"
+	"subscription_service/infrastructure/postgres/commands/price"
 	"subscription_service/infrastructure/postgres/commands/subscription"
+	"subscription_service/infrastructure/postgres/commands/suggestion"
 	http2 "subscription_service/internal/controllers/http"
 	"subscription_service/internal/controllers/http/middleware"
 	"subscription_service/internal/usecases"
@@ -18,15 +24,43 @@ import (
 var (
 	l              logger.Logger
 	postgresClient *postgres.Client
-
-	createSubscriptionUseCase usecases.CreateSubUseCase
-	updateSubscriptionUseCase usecases.UpdateSubUseCase
-	getSubscriptionUseCase    usecases.GetSubUseCase
-	getSubscriptionsUseCase   usecases.GetListSubUseCase
-	DeleteSubscriptionUseCase usecases.DeleteSubUseCase
-	CalculateTotalCostUseCase usecases.CalculateTotalCostUseCase
-
-	subRepo subscription.SubRepository
+	txManager      *postgres.TxManager
+
+	createSubscriptionUseCase  usecases.CreateSubUseCase
+	updateSubscriptionUseCase  usecases.UpdateSubUseCase
+	patchSubscriptionUseCase   usecases.PatchSubUseCase
+	getSubscriptionUseCase     usecases.GetSubUseCase
+	getSubscriptionsUseCase    usecases.GetListSubUseCase
+	DeleteSubscriptionUseCase  usecases.DeleteSubUseCase
+	CalculateTotalCostUseCase  usecases.CalculateTotalCostUseCase
+	costReportUseCase          usecases.CostReportUseCase
+	getSubPricesUseCase        usecases.GetSubPricesUseCase
+	getUserCalendarUseCase     usecases.GetUserCalendarUseCase
+	addSubPriceUseCase         usecases.AddSubPriceUseCase
+	loadExchangeRatesUseCase   usecases.LoadExchangeRatesUseCase
+	getEndingTrialsUseCase     usecases.GetEndingTrialsUseCase
+	pauseSubscriptionUseCase   usecases.PauseSubUseCase
+	resumeSubscriptionUseCase  usecases.ResumeSubUseCase
+	cancelSubscriptionUseCase  usecases.CancelSubUseCase
+	restoreSubscriptionUseCase usecases.RestoreSubUseCase
+	purgeSubscriptionsUseCase  usecases.PurgeSubsUseCase
+	getSubHistoryUseCase       usecases.GetSubHistoryUseCase
+	getAuditLogUseCase         usecases.GetAuditLogUseCase
+	batchSubscriptionsUseCase  usecases.BatchSubUseCase
+	importSubscriptionsUseCase usecases.ImportSubsUseCase
+	exportSubscriptionsUseCase usecases.ExportSubsUseCase
+	importStatementUseCase     usecases.ImportStatementUseCase
+	getSuggestionsUseCase      usecases.GetSuggestionsUseCase
+	acceptSuggestionUseCase    usecases.AcceptSuggestionUseCase
+	dismissSuggestionUseCase   usecases.DismissSuggestionUseCase
+
+	subRepo         subscription.SubRepository
+	idempotencyRepo idempotency.IdempotencyRepository
+	priceRepo       price.PriceRepository
+	rateRepo        exchangerate.ExchangeRateRepository
+	pauseRepo       pause.PauseRepository
+	auditRepo       audit.AuditRepository
+	suggestionRepo  suggestion.SuggestionRepository
 )
 
 func Run() {
@@ -37,23 +71,51 @@ func Run() {
 
 	initPackages(cfg)
 	initRepository()
-	initUseCases()
+	initUseCases(cfg)
 
 	defer postgresClient.Close()
 	runHTTP(cfg)
 }
 
-func initUseCases() {
-	createSubscriptionUseCase = usecases.NewCreateSubUseCase(subRepo, l)
+func initUseCases(cfg *config.Config) {
+	createSubscriptionUseCase = usecases.NewCreateSubUseCase(subRepo, idempotencyRepo, txManager, cfg.Idempotency.TTL, l)
 	updateSubscriptionUseCase = usecases.NewUpdateSubUseCase(subRepo, l)
+	patchSubscriptionUseCase = usecases.NewPatchSubUseCase(subRepo, l)
 	getSubscriptionUseCase = usecases.NewGetSubUseCase(subRepo, l)
 	getSubscriptionsUseCase = usecases.NewGetListSubUseCase(subRepo, l)
 	DeleteSubscriptionUseCase = usecases.NewDeleteSubUseCase(subRepo, l)
-	CalculateTotalCostUseCase = usecases.NewCalculateTotalCostUseCase(subRepo, l)
+	CalculateTotalCostUseCase = usecases.NewCalculateTotalCostUseCase(subRepo, priceRepo, pauseRepo, rateRepo, l)
+	costReportUseCase = usecases.NewCostReportUseCase(subRepo, priceRepo, pauseRepo, rateRepo, l)
+	getSubPricesUseCase = usecases.NewGetSubPricesUseCase(subRepo, priceRepo, l)
+	getUserCalendarUseCase = usecases.NewGetUserCalendarUseCase(subRepo, priceRepo, l)
+	addSubPriceUseCase = usecases.NewAddSubPriceUseCase(subRepo, l)
+	loadExchangeRatesUseCase = usecases.NewLoadExchangeRatesUseCase(rateRepo, l)
+	getEndingTrialsUseCase = usecases.NewGetEndingTrialsUseCase(subRepo, l)
+	pauseSubscriptionUseCase = usecases.NewPauseSubUseCase(subRepo, l)
+	resumeSubscriptionUseCase = usecases.NewResumeSubUseCase(subRepo, l)
+	cancelSubscriptionUseCase = usecases.NewCancelSubUseCase(subRepo, l)
+	restoreSubscriptionUseCase = usecases.NewRestoreSubUseCase(subRepo, l)
+	purgeSubscriptionsUseCase = usecases.NewPurgeSubsUseCase(subRepo, cfg.SoftDelete.Retention, l)
+	getSubHistoryUseCase = usecases.NewGetSubHistoryUseCase(auditRepo, l)
+	getAuditLogUseCase = usecases.NewGetAuditLogUseCase(auditRepo, l)
+	batchSubscriptionsUseCase = usecases.NewBatchSubUseCase(subRepo, txManager, l)
+	importSubscriptionsUseCase = usecases.NewImportSubsUseCase(subRepo, l)
+	exportSubscriptionsUseCase = usecases.NewExportSubsUseCase(subRepo, l)
+	importStatementUseCase = usecases.NewImportStatementUseCase(subRepo, suggestionRepo, l)
+	getSuggestionsUseCase = usecases.NewGetSuggestionsUseCase(suggestionRepo, l)
+	acceptSuggestionUseCase = usecases.NewAcceptSuggestionUseCase(suggestionRepo, subRepo, txManager, l)
+	dismissSuggestionUseCase = usecases.NewDismissSuggestionUseCase(suggestionRepo, l)
 }
 
 func initRepository() {
+	txManager = postgres.NewTxManager(postgresClient)
 	subRepo = subscription.NewSubRepository(postgresClient, l)
+	idempotencyRepo = idempotency.NewIdempotencyRepository(postgresClient, l)
+	priceRepo = price.NewPriceRepository(postgresClient, l)
+	rateRepo = exchangerate.NewExchangeRateRepository(postgresClient, l)
+	pauseRepo = pause.NewPauseRepository(postgresClient, l)
+	auditRepo = audit.NewAuditRepository(postgresClient, l)
+	suggestionRepo = suggestion.NewSuggestionRepository(postgresClient, l)
 }
 
 func initPackages(cfg *config.Config) {
@@ -88,11 +150,32 @@ func runHTTP(cfg *config.Config) {
 
 	http2.InitServiceMiddleware(router)
 	http2.NewCreateSubController(router, createSubscriptionUseCase, mw, l)
+	http2.NewBatchSubController(router, batchSubscriptionsUseCase, mw, l)
+	http2.NewImportSubsController(router, importSubscriptionsUseCase, mw, l)
+	http2.NewExportSubsController(router, exportSubscriptionsUseCase, mw, l)
+	http2.NewImportStatementController(router, importStatementUseCase, mw, l)
+	http2.NewGetSuggestionsController(router, getSuggestionsUseCase, mw, l)
+	http2.NewAcceptSuggestionController(router, acceptSuggestionUseCase, mw, l)
+	http2.NewDismissSuggestionController(router, dismissSuggestionUseCase, mw, l)
 	http2.NewUpdateSubController(router, updateSubscriptionUseCase, mw, l)
+	http2.NewPatchSubController(router, patchSubscriptionUseCase, mw, l)
 	http2.NewGetSubController(router, getSubscriptionUseCase, mw, l)
 	http2.NewGetListSubController(router, getSubscriptionsUseCase, mw, l)
 	http2.NewDeleteSubController(router, DeleteSubscriptionUseCase, mw, l)
 	http2.NewCalculateTotalCostController(router, CalculateTotalCostUseCase, mw, l)
+	http2.NewCostReportController(router, costReportUseCase, mw, l)
+	http2.NewGetSubPricesController(router, getSubPricesUseCase, mw, l)
+	http2.NewGetUserCalendarController(router, getUserCalendarUseCase, mw, l)
+	http2.NewAddSubPriceController(router, addSubPriceUseCase, mw, l)
+	http2.NewLoadExchangeRatesController(router, loadExchangeRatesUseCase, mw, l)
+	http2.NewGetEndingTrialsController(router, getEndingTrialsUseCase, mw, l)
+	http2.NewPauseSubController(router, pauseSubscriptionUseCase, mw, l)
+	http2.NewResumeSubController(router, resumeSubscriptionUseCase, mw, l)
+	http2.NewCancelSubController(router, cancelSubscriptionUseCase, mw, l)
+	http2.NewRestoreSubController(router, restoreSubscriptionUseCase, mw, l)
+	http2.NewPurgeSubsController(router, purgeSubscriptionsUseCase, mw, l)
+	http2.NewGetSubHistoryController(router, getSubHistoryUseCase, mw, l)
+	http2.NewGetAuditLogController(router, getAuditLogUseCase, mw, l)
 
 	address := fmt.Sprintf("%s:%s", cfg.HTTP.Host, cfg.HTTP.Port)
 	l.Info().Msgf("starting HTTP server on %s", address)
@@ -5,13 +5,16 @@ import (
 	"github.com/spf13/viper"
 	"os"
 	"subscription_service/config/pg"
+	"time"
 )
 
 type (
 	Config struct {
-		App  `mapstructure:"app"`
-		HTTP `mapstructure:"http"`
-		PG   pg.Config `mapstructure:"postgres"`
+		App         `mapstructure:"app"`
+		HTTP        `mapstructure:"http"`
+		PG          pg.Config `mapstructure:"postgres"`
+		Idempotency `mapstructure:"idempotency"`
+		SoftDelete  `mapstructure:"soft_delete"`
 	}
 
 	App struct {
@@ -24,6 +27,14 @@ type (
 		Host string `mapstructure:"host"`
 		Port string `mapstructure:"port"`
 	}
+
+	Idempotency struct {
+		TTL time.Duration `mapstructure:"ttl"`
+	}
+
+	SoftDelete struct {
+		Retention time.Duration `mapstructure:"retention"`
+	}
 )
 
 func New() (*Config, error) {
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys
+(
+    key VARCHAR(255) primary key,
+    request_hash CHAR(64) not null,
+    response JSONB not null,
+    created_at TIMESTAMPTZ not null default now(),
+    expires_at TIMESTAMPTZ not null
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS subscription_prices
+(
+    subscription_id UUID not null references subscriptions(id) on delete cascade,
+    effective_from DATE not null,
+    price INTEGER not null check (price > 0),
+    created_at TIMESTAMPTZ not null default now(),
+    primary key (subscription_id, effective_from)
+);
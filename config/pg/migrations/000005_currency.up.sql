@@ -0,0 +1,10 @@
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS currency CHAR(3) not null default 'RUB';
+
+CREATE TABLE IF NOT EXISTS exchange_rates
+(
+    base_currency CHAR(3) not null,
+    quote_currency CHAR(3) not null,
+    rate NUMERIC(20, 10) not null check (rate > 0),
+    updated_at TIMESTAMPTZ not null default now(),
+    primary key (base_currency, quote_currency)
+);
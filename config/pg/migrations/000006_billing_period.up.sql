@@ -0,0 +1,2 @@
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS billing_period VARCHAR(16) not null default 'monthly'
+    check (billing_period IN ('weekly', 'monthly', 'quarterly', 'yearly'));
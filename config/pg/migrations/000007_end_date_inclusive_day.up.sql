@@ -0,0 +1,5 @@
+-- end_date used to hold the first day of the last paid month; it is now the
+-- last paid day, so legacy values are moved to the end of their month.
+UPDATE subscriptions
+SET end_date = (date_trunc('month', end_date) + interval '1 month - 1 day')::date
+WHERE end_date IS NOT NULL AND extract(day FROM end_date) = 1;
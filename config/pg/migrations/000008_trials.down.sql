@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS subscriptions_trial_end_date_idx;
+
+ALTER TABLE subscriptions DROP CONSTRAINT IF EXISTS subscriptions_trial_check;
+
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS trial_end_date;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS trial_start_date;
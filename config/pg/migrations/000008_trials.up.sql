@@ -0,0 +1,8 @@
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS trial_start_date DATE;
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS trial_end_date DATE;
+
+ALTER TABLE subscriptions ADD CONSTRAINT subscriptions_trial_check
+    check ((trial_start_date IS NULL) = (trial_end_date IS NULL) AND trial_end_date >= trial_start_date);
+
+CREATE INDEX IF NOT EXISTS subscriptions_trial_end_date_idx ON subscriptions (trial_end_date)
+    WHERE trial_end_date IS NOT NULL;
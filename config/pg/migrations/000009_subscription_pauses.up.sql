@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS subscription_pauses
+(
+    subscription_id UUID not null references subscriptions(id) on delete cascade,
+    paused_from DATE not null,
+    resumed_at DATE check (resumed_at >= paused_from),
+    created_at TIMESTAMPTZ not null default now(),
+    primary key (subscription_id, paused_from)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS subscription_pauses_open_idx ON subscription_pauses (subscription_id)
+    WHERE resumed_at IS NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS cancel_reason;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS cancelled_at;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS audit_log
+(
+    id BIGSERIAL primary key,
+    -- no foreign key: the history outlives purged subscriptions
+    subscription_id UUID not null,
+    action VARCHAR(16) not null,
+    actor VARCHAR(255) not null,
+    request_id VARCHAR(255) not null default '',
+    before JSONB,
+    after JSONB,
+    created_at TIMESTAMPTZ not null default now()
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_subscription_id_idx ON audit_log (subscription_id, id);
+CREATE INDEX IF NOT EXISTS audit_log_created_at_idx ON audit_log (created_at);
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS subscription_suggestions
+(
+    id UUID default gen_random_uuid() primary key,
+    user_id UUID not null,
+    -- merchant name normalized so that the same debits found in another statement update the suggestion
+    merchant VARCHAR(255) not null,
+    service_name VARCHAR(255) not null,
+    price INTEGER not null check (price > 0),
+    currency CHAR(3) not null,
+    billing_period VARCHAR(16) not null default 'monthly'
+        check (billing_period IN ('weekly', 'monthly', 'quarterly', 'yearly')),
+    first_charge_date DATE not null,
+    last_charge_date DATE not null check (last_charge_date >= first_charge_date),
+    charges INTEGER not null check (charges > 0),
+    status VARCHAR(16) not null default 'pending'
+        check (status IN ('pending', 'accepted', 'dismissed')),
+    -- no foreign key: the subscription may be purged later
+    subscription_id UUID,
+    created_at TIMESTAMPTZ not null default now(),
+    updated_at TIMESTAMPTZ not null default now(),
+    unique (user_id, merchant, currency)
+);
+
+CREATE INDEX IF NOT EXISTS subscription_suggestions_user_id_idx ON subscription_suggestions (user_id, status);
@@ -15,40 +15,39 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/subscriptions": {
-            "get": {
-                "description": "Возвращает список подписок с поддержкой пагинации",
+        "/admin/exchange-rates": {
+            "post": {
+                "description": "Загружает курсы валют в формате JSON или CSV (Content-Type: text/csv, заголовок base,quote,rate).\nКурс пары, загруженной ранее, заменяется новым.",
+                "consumes": [
+                    "application/json",
+                    "text/csv"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "subscriptions"
+                    "admin"
                 ],
-                "summary": "Получение списка подписок",
+                "summary": "Загрузка курсов валют",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Количество подписок на странице",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Смещение",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "курсы валют",
+                        "name": "rates",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/requests.ExchangeRate"
+                            }
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/responses.SubResponse"
-                            }
+                            "$ref": "#/definitions/responses.LoadExchangeRates"
                         }
                     },
                     "400": {
@@ -64,41 +63,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/subscriptions/purge": {
             "post": {
-                "description": "Создание новой записи подписки",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Окончательно удаляет подписки, удалённые раньше, чем срок хранения из конфигурации (soft_delete.retention)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "subscriptions"
-                ],
-                "summary": "Создание подписки",
-                "parameters": [
-                    {
-                        "description": "структура запроса",
-                        "name": "subscription",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/requests.SubRequest"
-                        }
-                    }
+                    "admin"
                 ],
+                "summary": "Очистка удалённых подписок",
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/responses.SubResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "некорректный формат запроса",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/responses.PurgeSubscriptions"
                         }
                     },
                     "500": {
@@ -110,35 +91,80 @@ const docTemplate = `{
                 }
             }
         },
-        "/subscriptions/total": {
-            "post": {
-                "description": "Расчет общей стоимость подписок за определенный период с использованием дополнительных фильтров",
-                "consumes": [
-                    "application/json"
-                ],
+        "/audit": {
+            "get": {
+                "description": "Возвращает записи журнала аудита всех подписок от старых к новым с фильтрацией по подписке, автору, действию и периоду",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "subscriptions"
+                    "audit"
                 ],
-                "summary": "Рассчет общую стоимость подписки",
+                "summary": "Журнал аудита",
                 "parameters": [
                     {
-                        "description": "структура запроса",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/requests.CalculateTotalCost"
-                        }
+                        "type": "string",
+                        "description": "ID подписки",
+                        "name": "subscription_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "автор изменения (заголовок X-Actor)",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "update",
+                            "delete",
+                            "restore",
+                            "purge",
+                            "pause",
+                            "resume",
+                            "price"
+                        ],
+                        "type": "string",
+                        "description": "действие",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "первый день или месяц периода (YYYY-MM-DD или MM-YYYY)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "последний день или месяц периода включительно (YYYY-MM-DD или MM-YYYY)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "количество записей",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "смещение",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.CalculateTotalCost"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.AuditEntry"
+                            }
                         }
                     },
                     "400": {
@@ -156,30 +182,118 @@ const docTemplate = `{
                 }
             }
         },
-        "/subscriptions/{sub_id}": {
+        "/subscriptions": {
             "get": {
-                "description": "Запрос на получение подписки по ее ID",
+                "description": "Возвращает список подписок с поддержкой пагинации, фильтрации и сортировки.\nЕсли передан параметр cursor (пустой для первой страницы), используется курсорная пагинация.\nПо умолчанию возвращается массив подписок, как в предыдущих версиях API. При envelope=true или курсорной пагинации\nответ оборачивается в объект с общим количеством записей и ссылками на соседние страницы.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "subscriptions"
                 ],
-                "summary": "Запрос на получение подписки",
+                "summary": "Получение списка подписок",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Количество подписок на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение",
+                        "name": "offset",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "path format",
-                        "name": "sub_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Курсор следующей страницы из next_cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Точное название сервиса",
+                        "name": "service_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало названия сервиса без учета регистра",
+                        "name": "service_name_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Минимальная цена, действующая сегодня",
+                        "name": "min_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Максимальная цена, действующая сегодня",
+                        "name": "max_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "День (YYYY-MM-DD) или месяц (MM-YYYY), в котором подписка активна",
+                        "name": "active_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Статусы подписки на сегодня через запятую: scheduled, trial, active, paused, cancelled, expired",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "id",
+                            "service_name",
+                            "price",
+                            "user_id",
+                            "start_date",
+                            "end_date"
+                        ],
+                        "type": "string",
+                        "default": "id",
+                        "description": "Поле сортировки",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "asc",
+                        "description": "Направление сортировки",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Оборачивать ответ в объект с метаданными пагинации",
+                        "name": "envelope",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "при envelope=true или курсорной пагинации",
                         "schema": {
-                            "$ref": "#/definitions/responses.SubResponse"
+                            "$ref": "#/definitions/responses.SubList"
                         }
                     },
                     "400": {
@@ -188,12 +302,6 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "подписка не найдена",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
                     "500": {
                         "description": "внутренняя ошибка сервера",
                         "schema": {
@@ -202,8 +310,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Обновление подписки по ID",
+            "post": {
+                "description": "Создание новой записи подписки",
                 "consumes": [
                     "application/json"
                 ],
@@ -213,14 +321,13 @@ const docTemplate = `{
                 "tags": [
                     "subscriptions"
                 ],
-                "summary": "Обновление подписки",
+                "summary": "Создание подписки",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "path format",
-                        "name": "sub_id",
-                        "in": "path",
-                        "required": true
+                        "description": "ключ идемпотентности, повторный запрос с тем же ключом возвращает сохраненный ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     },
                     {
                         "description": "структура запроса",
@@ -233,10 +340,16 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/responses.SubResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "версия подписки"
+                            }
                         }
                     },
                     "400": {
@@ -245,8 +358,14 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "подписка не найдена",
+                    "409": {
+                        "description": "одновременный запрос с тем же ключом идемпотентности",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "ключ идемпотентности использован с другим запросом",
                         "schema": {
                             "type": "string"
                         }
@@ -258,37 +377,47 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Удаление подписки по ID",
+            }
+        },
+        "/subscriptions/batch": {
+            "post": {
+                "description": "Создает, заменяет и удаляет подписки одним запросом, до 5000 элементов каждого вида. Каждый элемент проверяется отдельно. При atomic=true применяются либо все элементы, либо ни один; иначе каждый элемент применяется независимо. Результат каждого элемента содержит HTTP-статус, который он получил бы отдельным запросом",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "subscriptions"
                 ],
-                "summary": "Удаление подписки",
+                "summary": "Пакетное создание, изменение и удаление подписок",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "path format",
-                        "name": "sub_id",
-                        "in": "path",
-                        "required": true
+                        "description": "структура запроса",
+                        "name": "batch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.SubBatchRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "все элементы применены",
+                        "schema": {
+                            "$ref": "#/definitions/responses.SubBatch"
+                        }
                     },
-                    "400": {
-                        "description": "некорректный формат запроса",
+                    "207": {
+                        "description": "часть элементов (или при atomic=true все) не применена",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/responses.SubBatch"
                         }
                     },
-                    "404": {
-                        "description": "подписка не найдена",
+                    "400": {
+                        "description": "некорректный формат запроса или пустой пакет",
                         "schema": {
                             "type": "string"
                         }
@@ -301,33 +430,1620 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "requests.CalculateTotalCost": {
-            "type": "object",
-            "required": [
-                "end_period",
-                "start_period"
-            ],
-            "properties": {
-                "end_period": {
-                    "type": "string",
-                    "example": "12-2025"
-                },
-                "service_name": {
-                    "type": "string",
-                    "example": "Yandex Plus"
-                },
-                "start_period": {
-                    "type": "string",
-                    "example": "07-2025"
-                },
-                "user_id": {
-                    "type": "string",
-                    "example": "60601fee-2bf1-4721-ae6f-7636e79a0cba"
-                }
-            }
+        },
+        "/subscriptions/export": {
+            "get": {
+                "description": "Потоково выгружает все подписки, подходящие под фильтры списка подписок, в CSV с заголовком или в JSON Lines (по объекту подписки на строку).\nСтроки читаются из базы по мере отправки клиенту, поэтому выгрузка не ограничена по размеру. Если ошибка произошла после начала выгрузки, ответ обрывается.",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Выгрузка подписок",
+                "parameters": [
+                    {
+                        "enum": [
+                            "csv",
+                            "jsonl"
+                        ],
+                        "type": "string",
+                        "default": "csv",
+                        "description": "Формат выгрузки",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Точное название сервиса",
+                        "name": "service_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало названия сервиса без учета регистра",
+                        "name": "service_name_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Минимальная цена, действующая сегодня",
+                        "name": "min_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Максимальная цена, действующая сегодня",
+                        "name": "max_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "День (YYYY-MM-DD) или месяц (MM-YYYY), в котором подписка активна",
+                        "name": "active_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Статусы подписки на сегодня через запятую: scheduled, trial, active, paused, cancelled, expired",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "id",
+                            "service_name",
+                            "price",
+                            "user_id",
+                            "start_date",
+                            "end_date"
+                        ],
+                        "type": "string",
+                        "default": "id",
+                        "description": "Поле сортировки",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "asc",
+                        "description": "Направление сортировки",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "подписки в выбранном формате",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscriptions/import": {
+            "post": {
+                "description": "Загружает подписки из CSV с заголовком. Колонки с именами полей запроса создания подписки (service_name, price, currency, billing_period, user_id, start_date, end_date, trial_start_date, trial_end_date) сопоставляются автоматически, остальные можно сопоставить параметром map, несопоставленные игнорируются.\nКаждая строка проверяется так же, как при создании подписки. При dry_run=true возвращаются только ошибки строк, иначе корректные строки добавляются в одной транзакции, а строки с ошибками пропускаются.",
+                "consumes": [
+                    "text/csv"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Импорт подписок из CSV",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": ",",
+                        "description": "разделитель полей",
+                        "name": "delimiter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "сопоставление колонки полю в виде колонка:поле",
+                        "name": "map",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "только проверить строки",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "description": "CSV с заголовком",
+                        "name": "file",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ImportSubscriptions"
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный CSV, разделитель или сопоставление колонок",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscriptions/total": {
+            "post": {
+                "description": "Расчет общей стоимость подписок за определенный период с использованием дополнительных фильтров",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Рассчет общую стоимость подписки",
+                "parameters": [
+                    {
+                        "description": "структура запроса",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.CalculateTotalCost"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.CalculateTotalCost"
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscriptions/total/xlsx": {
+            "post": {
+                "description": "Считает стоимость подписок так же, как расчет общей стоимости, и возвращает книгу XLSX из двух листов.\nЛист «Подписки» содержит по строке на подписку (сервис, пользователь, число оплаченных месяцев, сумма), лист «Итоги» — суммы по сервисам и месяцам. Параметр group_by не поддерживается, период — не длиннее 16382 месяцев.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Отчет о стоимости подписок в XLSX",
+                "parameters": [
+                    {
+                        "description": "структура запроса",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.CalculateTotalCost"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "книга XLSX",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса, передан group_by или слишком длинный период",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscriptions/trials/ending": {
+            "get": {
+                "description": "Возвращает подписки, пробный период которых заканчивается в ближайшие within (от сегодняшнего дня включительно) и после которого начнутся списания",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Подписки с заканчивающимся пробным периодом",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "7d",
+                        "description": "окно поиска в днях или неделях: 7d, 2w; не больше 366 дней",
+                        "name": "within",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.SubResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscriptions/{sub_id}": {
+            "get": {
+                "description": "Запрос на получение подписки по ее ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Запрос на получение подписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "path format",
+                        "name": "sub_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.SubResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "версия подписки"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "подписка не найдена",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Обновление подписки по ID. price должна совпадать с действующей ценой, новая цена добавляется через POST /subscriptions/{sub_id}/prices",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Обновление подписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "path format",
+                        "name": "sub_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag текущей версии подписки; значение * не принимается",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "структура запроса",
+                        "name": "subscription",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.SubRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.SubResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "новая версия подписки"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "подписка не найдена",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "подписка была изменена",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "428": {
+                        "description": "не передан заголовок If-Match или передано значение *",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Удаление подписки по ID. Удалённую подписку можно восстановить до очистки через POST /subscriptions/{sub_id}/restore",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Удаление подписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "path format",
+                        "name": "sub_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag текущей версии подписки; значение * не принимается",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "подписка не найдена",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "подписка была изменена",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "428": {
+                        "description": "не передан заголовок If-Match или передано значение *",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Частичное обновление подписки по ID в формате JSON Merge Patch (RFC 7396).\nОтсутствующие поля не изменяются, end_date: null снимает дату окончания.\nЦена меняется только через POST /subscriptions/{sub_id}/prices.",
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Частичное обновление подписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "path format",
+                        "name": "sub_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag текущей версии подписки; значение * не принимается",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "изменяемые поля",
+                        "name": "subscription",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.SubPatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.SubResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "новая версия подписки"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "подписка не найдена",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "подписка была изменена",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "428": {
+                        "description": "не передан заголовок If-Match или передано значение *",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscriptions/{sub_id}/cancel": {
+            "post": {
+                "description": "Устанавливает end_date на последний день текущего или указанного месяца и сохраняет время и причину отмены",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Отмена подписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "path format",
+                        "name": "sub_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "структура запроса",
+                        "name": "cancel",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/requests.CancelSubscription"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.SubResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "новая версия подписки"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "подписка не найдена",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "подписка уже отменена или истекла",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "подписку изменили во время отмены",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscriptions/{sub_id}/history": {
+            "get": {
+                "description": "Возвращает записи журнала аудита подписки от старых к новым: кто, когда и в рамках какого запроса изменил подписку, с состоянием до и после изменения. История сохраняется и после очистки подписки",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "audit"
+                ],
+                "summary": "История изменений подписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "path format",
+                        "name": "sub_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "количество записей",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "смещение",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.AuditEntry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscriptions/{sub_id}/pause": {
+            "post": {
+                "description": "Приостанавливает подписку с даты from (по умолчанию сегодня) до возобновления. Дни приостановки не учитываются в подсчете стоимости",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Приостановка подписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "path format",
+                        "name": "sub_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "структура запроса",
+                        "name": "pause",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/requests.PauseSubscription"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.SubResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "подписка не найдена",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "подписка уже приостановлена",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscriptions/{sub_id}/prices": {
+            "get": {
+                "description": "Возвращает цену подписки на момент создания и все последующие изменения цены в порядке вступления в силу",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Получение истории цен подписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "path format",
+                        "name": "sub_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.SubPrice"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "подписка не найдена",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Добавляет новую цену подписки, действующую с указанного месяца. Цены прошлых месяцев не меняются",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Изменение цены подписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "path format",
+                        "name": "sub_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "структура запроса",
+                        "name": "price",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.SubPriceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/responses.SubPrice"
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "подписка не найдена",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "цена с этого месяца уже задана",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscriptions/{sub_id}/restore": {
+            "post": {
+                "description": "Восстанавливает удалённую подписку, если она ещё не была окончательно удалена очисткой",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Восстановление подписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "path format",
+                        "name": "sub_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.SubResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "новая версия подписки"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "удалённая подписка не найдена",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/subscriptions/{sub_id}/resume": {
+            "post": {
+                "description": "Завершает текущую приостановку: подписка снова оплачивается с даты on (по умолчанию сегодня)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Возобновление подписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "path format",
+                        "name": "sub_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "структура запроса",
+                        "name": "resume",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/requests.ResumeSubscription"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.SubResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "подписка не найдена",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "подписка не приостановлена",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/statements": {
+            "post": {
+                "description": "Разбирает выписку в формате OFX или ISO 20022 CAMT.053 и ищет регулярные списания: не меньше трех списаний одному получателю с интервалом около месяца и отличием суммы не больше 10%, последнее из которых было не раньше чем за 45 дней до конца выписки.\nНайденные списания, для которых у пользователя нет действующей подписки на тот же сервис, сохраняются как предложения подписок с ценой последнего списания. Повторная загрузка выписки обновляет предложения, а не дублирует их.",
+                "consumes": [
+                    "application/x-ofx",
+                    "application/xml"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suggestions"
+                ],
+                "summary": "Загрузка банковской выписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "ofx",
+                            "camt053"
+                        ],
+                        "type": "string",
+                        "description": "формат выписки, по умолчанию определяется по содержимому",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "description": "выписка",
+                        "name": "file",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ImportStatement"
+                        }
+                    },
+                    "400": {
+                        "description": "некорректная выписка или формат",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/subscriptions.ics": {
+            "get": {
+                "description": "Возвращает календарь iCalendar (RFC 5545) с повторяющимся событием на каждую еще не закончившуюся подписку пользователя.\nСобытие начинается в первый оплачиваемый день (после пробного периода), повторяется с периодом оплаты до end_date и содержит название сервиса и цену ближайшего продления. UID события постоянен для подписки, поэтому календарь можно подключить по ссылке.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Календарь продлений подписок пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "календарь iCalendar",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/suggestions": {
+            "get": {
+                "description": "Возвращает подписки, найденные в загруженных банковских выписках пользователя, которые еще не приняты и не отклонены",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suggestions"
+                ],
+                "summary": "Предложения подписок пользователя",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Suggestion"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/suggestions/{suggestion_id}/accept": {
+            "post": {
+                "description": "Создает подписку из предложения. Подписка начинается с первого найденного списания, поля запроса заменяют предложенные значения",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suggestions"
+                ],
+                "summary": "Принятие предложения подписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID предложения",
+                        "name": "suggestion_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "структура запроса",
+                        "name": "suggestion",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/requests.AcceptSuggestion"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/responses.SubResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "версия подписки"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "предложение не найдено",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "предложение уже принято или отклонено",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{user_id}/suggestions/{suggestion_id}/dismiss": {
+            "post": {
+                "description": "Скрывает предложение. Повторная загрузка выписки не возвращает отклоненное предложение",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suggestions"
+                ],
+                "summary": "Отклонение предложения подписки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID предложения",
+                        "name": "suggestion_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "некорректный формат запроса",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "предложение не найдено",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "предложение уже принято или отклонено",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "внутренняя ошибка сервера",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "requests.AcceptSuggestion": {
+            "type": "object",
+            "properties": {
+                "billing_period": {
+                    "type": "string",
+                    "enum": [
+                        "weekly",
+                        "monthly",
+                        "quarterly",
+                        "yearly"
+                    ],
+                    "example": "monthly"
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "12-2025"
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 999
+                },
+                "service_name": {
+                    "type": "string",
+                    "example": "Netflix"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2025-03-05"
+                }
+            }
+        },
+        "requests.CalculateTotalCost": {
+            "type": "object",
+            "required": [
+                "end_period",
+                "start_period"
+            ],
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "end_period": {
+                    "type": "string",
+                    "example": "12-2025"
+                },
+                "group_by": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "service",
+                        "month"
+                    ]
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "charge",
+                        "amortized"
+                    ],
+                    "example": "amortized"
+                },
+                "proration": {
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "daily",
+                        "half_month"
+                    ],
+                    "example": "daily"
+                },
+                "service_name": {
+                    "type": "string",
+                    "example": "Yandex Plus"
+                },
+                "start_period": {
+                    "type": "string",
+                    "example": "07-2025"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "60601fee-2bf1-4721-ae6f-7636e79a0cba"
+                }
+            }
+        },
+        "requests.CancelSubscription": {
+            "type": "object",
+            "properties": {
+                "month": {
+                    "type": "string",
+                    "example": "08-2025"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "слишком дорого"
+                }
+            }
+        },
+        "requests.ExchangeRate": {
+            "type": "object",
+            "required": [
+                "base",
+                "quote",
+                "rate"
+            ],
+            "properties": {
+                "base": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "quote": {
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "rate": {
+                    "type": "number",
+                    "example": 92.5
+                }
+            }
+        },
+        "requests.PauseSubscription": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string",
+                    "example": "2025-06-01"
+                }
+            }
+        },
+        "requests.ResumeSubscription": {
+            "type": "object",
+            "properties": {
+                "on": {
+                    "type": "string",
+                    "example": "2025-09-01"
+                }
+            }
+        },
+        "requests.SubBatchDelete": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "6060ffee-2bf1-4721-ae6f-7636e979a0cb"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "requests.SubBatchRequest": {
+            "type": "object",
+            "properties": {
+                "atomic": {
+                    "type": "boolean"
+                },
+                "create": {
+                    "type": "array",
+                    "maxItems": 5000,
+                    "items": {
+                        "$ref": "#/definitions/requests.SubRequest"
+                    }
+                },
+                "delete": {
+                    "type": "array",
+                    "maxItems": 5000,
+                    "items": {
+                        "$ref": "#/definitions/requests.SubBatchDelete"
+                    }
+                },
+                "update": {
+                    "type": "array",
+                    "maxItems": 5000,
+                    "items": {
+                        "$ref": "#/definitions/requests.SubBatchUpdate"
+                    }
+                }
+            }
+        },
+        "requests.SubBatchUpdate": {
+            "type": "object",
+            "required": [
+                "price",
+                "service_name",
+                "start_date",
+                "user_id"
+            ],
+            "properties": {
+                "billing_period": {
+                    "type": "string",
+                    "enum": [
+                        "weekly",
+                        "monthly",
+                        "quarterly",
+                        "yearly"
+                    ],
+                    "example": "monthly"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "12-2025"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "6060ffee-2bf1-4721-ae6f-7636e979a0cb"
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "service_name": {
+                    "type": "string",
+                    "example": "Yandex Plus"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "07-2025"
+                },
+                "trial_end_date": {
+                    "type": "string",
+                    "example": "2025-07-14"
+                },
+                "trial_start_date": {
+                    "type": "string",
+                    "example": "2025-07-01"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "60601fee-2bf1-4721-ae6f-7636e79a0cba"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "requests.SubPatchRequest": {
+            "type": "object",
+            "properties": {
+                "billing_period": {
+                    "type": "string",
+                    "example": "yearly"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "12-2025"
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "service_name": {
+                    "type": "string",
+                    "example": "Yandex Plus"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "07-2025"
+                },
+                "trial_end_date": {
+                    "type": "string",
+                    "example": "2025-07-14"
+                },
+                "trial_start_date": {
+                    "type": "string",
+                    "example": "2025-07-01"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "60601fee-2bf1-4721-ae6f-7636e79a0cba"
+                }
+            }
+        },
+        "requests.SubPriceRequest": {
+            "type": "object",
+            "required": [
+                "effective_from",
+                "price"
+            ],
+            "properties": {
+                "effective_from": {
+                    "type": "string",
+                    "example": "01-2026"
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 500
+                }
+            }
         },
         "requests.SubRequest": {
             "type": "object",
@@ -338,6 +2054,20 @@ const docTemplate = `{
                 "user_id"
             ],
             "properties": {
+                "billing_period": {
+                    "type": "string",
+                    "enum": [
+                        "weekly",
+                        "monthly",
+                        "quarterly",
+                        "yearly"
+                    ],
+                    "example": "monthly"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                },
                 "end_date": {
                     "type": "string",
                     "example": "12-2025"
@@ -354,33 +2084,336 @@ const docTemplate = `{
                     "type": "string",
                     "example": "07-2025"
                 },
+                "trial_end_date": {
+                    "type": "string",
+                    "example": "2025-07-14"
+                },
+                "trial_start_date": {
+                    "type": "string",
+                    "example": "2025-07-01"
+                },
                 "user_id": {
                     "type": "string",
                     "example": "60601fee-2bf1-4721-ae6f-7636e79a0cba"
                 }
             }
         },
+        "responses.AuditEntry": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "create",
+                        "update",
+                        "delete",
+                        "restore",
+                        "purge"
+                    ]
+                },
+                "actor": {
+                    "type": "string"
+                },
+                "after": {
+                    "type": "object"
+                },
+                "before": {
+                    "type": "object"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "subscription_id": {
+                    "type": "string"
+                }
+            }
+        },
         "responses.CalculateTotalCost": {
             "type": "object",
             "required": [
+                "currency",
+                "mode",
+                "months",
+                "proration",
+                "total",
+                "unprorated_total"
+            ],
+            "properties": {
+                "breakdown": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.CostBreakdown"
+                    }
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "mode": {
+                    "type": "string",
+                    "example": "charge"
+                },
+                "months": {
+                    "type": "integer"
+                },
+                "proration": {
+                    "type": "string",
+                    "example": "none"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "unprorated_total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "responses.CostBreakdown": {
+            "type": "object",
+            "required": [
+                "months",
+                "total",
+                "unprorated_total"
+            ],
+            "properties": {
+                "month": {
+                    "type": "string",
+                    "example": "07-2025"
+                },
+                "months": {
+                    "type": "integer"
+                },
+                "service_name": {
+                    "type": "string",
+                    "example": "Yandex Plus"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "unprorated_total": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string",
+                    "example": "60601fee-2bf1-4721-ae6f-7636e79a0cba"
+                }
+            }
+        },
+        "responses.ImportRowError": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "line": {
+                    "type": "integer"
+                }
+            }
+        },
+        "responses.ImportStatement": {
+            "type": "object",
+            "required": [
+                "debits",
+                "format",
+                "suggested",
+                "transactions"
+            ],
+            "properties": {
+                "debits": {
+                    "type": "integer"
+                },
+                "format": {
+                    "type": "string",
+                    "enum": [
+                        "ofx",
+                        "camt053"
+                    ],
+                    "example": "ofx"
+                },
+                "suggested": {
+                    "description": "Suggested counts the recurring debits found that the user doesn't have a subscription for.",
+                    "type": "integer"
+                },
+                "transactions": {
+                    "type": "integer"
+                }
+            }
+        },
+        "responses.ImportSubscriptions": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.ImportRowError"
+                    }
+                },
+                "imported": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "valid": {
+                    "type": "integer"
+                }
+            }
+        },
+        "responses.LoadExchangeRates": {
+            "type": "object",
+            "required": [
+                "loaded"
+            ],
+            "properties": {
+                "loaded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "responses.PurgeSubscriptions": {
+            "type": "object",
+            "required": [
+                "purged"
+            ],
+            "properties": {
+                "purged": {
+                    "type": "integer"
+                }
+            }
+        },
+        "responses.SubBatch": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.SubBatchItem"
+                    }
+                }
+            }
+        },
+        "responses.SubBatchItem": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "operation": {
+                    "type": "string",
+                    "enum": [
+                        "create",
+                        "update",
+                        "delete"
+                    ]
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "subscription": {
+                    "$ref": "#/definitions/responses.SubResponse"
+                }
+            }
+        },
+        "responses.SubList": {
+            "type": "object",
+            "required": [
+                "items",
+                "limit",
                 "total"
             ],
             "properties": {
+                "cursor": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.SubResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next": {
+                    "type": "string",
+                    "example": "/subscriptions?limit=10\u0026offset=10"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "prev": {
+                    "type": "string",
+                    "example": "/subscriptions?limit=10\u0026offset=0"
+                },
                 "total": {
                     "type": "integer"
                 }
             }
         },
+        "responses.SubPrice": {
+            "type": "object",
+            "required": [
+                "effective_from",
+                "price"
+            ],
+            "properties": {
+                "effective_from": {
+                    "type": "string",
+                    "example": "01-2026"
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 500
+                }
+            }
+        },
         "responses.SubResponse": {
             "type": "object",
             "required": [
+                "billing_period",
+                "currency",
                 "id",
                 "price",
                 "service_name",
                 "start_date",
+                "status",
                 "user_id"
             ],
             "properties": {
+                "billing_period": {
+                    "type": "string",
+                    "example": "monthly"
+                },
+                "cancel_reason": {
+                    "type": "string",
+                    "example": "слишком дорого"
+                },
+                "cancelled_at": {
+                    "type": "string",
+                    "example": "2025-07-15T10:00:00Z"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                },
                 "end_date": {
                     "type": "string"
                 },
@@ -396,10 +2429,74 @@ const docTemplate = `{
                 "start_date": {
                     "type": "string"
                 },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "scheduled",
+                        "trial",
+                        "active",
+                        "paused",
+                        "cancelled",
+                        "expired"
+                    ],
+                    "example": "active"
+                },
+                "trial_end_date": {
+                    "type": "string"
+                },
+                "trial_start_date": {
+                    "type": "string"
+                },
                 "user_id": {
                     "type": "string"
                 }
             }
+        },
+        "responses.Suggestion": {
+            "type": "object",
+            "required": [
+                "billing_period",
+                "charges",
+                "currency",
+                "first_charge_date",
+                "id",
+                "last_charge_date",
+                "price",
+                "service_name"
+            ],
+            "properties": {
+                "billing_period": {
+                    "type": "string",
+                    "example": "monthly"
+                },
+                "charges": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "first_charge_date": {
+                    "type": "string",
+                    "example": "2025-03-05"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_charge_date": {
+                    "type": "string",
+                    "example": "2025-06-05"
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 999
+                },
+                "service_name": {
+                    "type": "string",
+                    "example": "NETFLIX.COM"
+                }
+            }
         }
     }
 }`
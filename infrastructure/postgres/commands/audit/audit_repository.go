@@ -0,0 +1,26 @@
+package audit
+
+import (
+	"context"
+	"subscription_service/infrastructure/postgres"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+)
+
+type auditRepo struct {
+	client *postgres.Client
+	logger logger.Logger
+}
+
+// AuditRepository reads the audit log. Entries are written by the
+// subscription repository in the transaction of each change.
+type AuditRepository interface {
+	SelectAll(ctx context.Context, filter entities.AuditFilter) ([]entities.AuditEntry, error)
+}
+
+func NewAuditRepository(client *postgres.Client, logger logger.Logger) AuditRepository {
+	return &auditRepo{
+		client: client,
+		logger: logger,
+	}
+}
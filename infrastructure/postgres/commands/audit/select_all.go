@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+)
+
+// SelectAll returns audit entries matching the filter in the order they were recorded.
+func (r *auditRepo) SelectAll(ctx context.Context, filter entities.AuditFilter) ([]entities.AuditEntry, error) {
+	builder := r.client.Builder.
+		Select(
+			commands.AuditLogIDField,
+			commands.AuditLogSubscriptionIDField,
+			commands.AuditLogActionField,
+			commands.AuditLogActorField,
+			commands.AuditLogRequestIDField,
+			commands.AuditLogBeforeField,
+			commands.AuditLogAfterField,
+			commands.AuditLogCreatedAtField,
+		).
+		From(commands.AuditLogTable).
+		OrderBy(commands.AuditLogIDField).
+		Limit(uint64(filter.Limit)).
+		Offset(uint64(filter.Offset))
+
+	if filter.SubscriptionID != nil {
+		builder = builder.Where("subscription_id = ?", *filter.SubscriptionID)
+	}
+	if filter.Actor != nil {
+		builder = builder.Where("actor = ?", *filter.Actor)
+	}
+	if filter.Action != nil {
+		builder = builder.Where("action = ?", *filter.Action)
+	}
+	if filter.From != nil {
+		builder = builder.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		builder = builder.Where("created_at < ?", *filter.To)
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build select audit query")
+		return nil, errors.Wrap(err, "failed to build query")
+	}
+
+	rows, err := r.client.Conn(ctx).Query(ctx, sql, args...)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute select audit query")
+		return nil, errors.Wrap(err, "failed to get audit log")
+	}
+	defer rows.Close()
+
+	var entries []entities.AuditEntry
+	for rows.Next() {
+		var entry entities.AuditEntry
+		err := rows.Scan(
+			&entry.ID,
+			&entry.SubscriptionID,
+			&entry.Action,
+			&entry.Actor,
+			&entry.RequestID,
+			&entry.Before,
+			&entry.After,
+			&entry.CreatedAt,
+		)
+		if err != nil {
+			r.logger.Error().Err(err).Msg("Failed to scan audit row")
+			return nil, errors.Wrap(err, "failed to scan audit entry")
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error().Err(err).Msg("Error iterating audit rows")
+		return nil, errors.Wrap(err, "failed to get audit log")
+	}
+
+	return entries, nil
+}
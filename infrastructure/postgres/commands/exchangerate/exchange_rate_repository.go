@@ -0,0 +1,25 @@
+package exchangerate
+
+import (
+	"context"
+	"subscription_service/infrastructure/postgres"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+)
+
+type exchangeRateRepo struct {
+	client *postgres.Client
+	logger logger.Logger
+}
+
+type ExchangeRateRepository interface {
+	Upsert(ctx context.Context, rates []entities.ExchangeRate) error
+	SelectAll(ctx context.Context) ([]entities.ExchangeRate, error)
+}
+
+func NewExchangeRateRepository(client *postgres.Client, logger logger.Logger) ExchangeRateRepository {
+	return &exchangeRateRepo{
+		client: client,
+		logger: logger,
+	}
+}
@@ -0,0 +1,51 @@
+package exchangerate
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+)
+
+func (r *exchangeRateRepo) SelectAll(ctx context.Context) ([]entities.ExchangeRate, error) {
+	sql, args, err := r.client.Builder.
+		Select(
+			commands.ExchangeRateBaseCurrencyField,
+			commands.ExchangeRateQuoteCurrencyField,
+			commands.ExchangeRateRateField,
+		).
+		From(commands.ExchangeRateTable).
+		OrderBy(
+			commands.ExchangeRateBaseCurrencyField,
+			commands.ExchangeRateQuoteCurrencyField,
+		).
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build select exchange rates query")
+		return nil, errors.Wrap(err, "failed to build query")
+	}
+
+	rows, err := r.client.Conn(ctx).Query(ctx, sql, args...)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute select exchange rates query")
+		return nil, errors.Wrap(err, "failed to get exchange rates")
+	}
+	defer rows.Close()
+
+	var rates []entities.ExchangeRate
+	for rows.Next() {
+		var rate entities.ExchangeRate
+		if err := rows.Scan(&rate.Base, &rate.Quote, &rate.Rate); err != nil {
+			r.logger.Error().Err(err).Msg("Failed to scan exchange rate row")
+			return nil, errors.Wrap(err, "failed to scan exchange rate")
+		}
+		rates = append(rates, rate)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error().Err(err).Msg("Error iterating exchange rate rows")
+		return nil, errors.Wrap(err, "failed to get exchange rates")
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,40 @@
+package exchangerate
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+)
+
+// Upsert inserts the rates in one statement, replacing the rate of pairs that are already loaded.
+func (r *exchangeRateRepo) Upsert(ctx context.Context, rates []entities.ExchangeRate) error {
+	builder := r.client.Builder.
+		Insert(commands.ExchangeRateTable).
+		Columns(
+			commands.ExchangeRateBaseCurrencyField,
+			commands.ExchangeRateQuoteCurrencyField,
+			commands.ExchangeRateRateField,
+		).
+		Suffix(`ON CONFLICT (` + commands.ExchangeRateBaseCurrencyField + `, ` + commands.ExchangeRateQuoteCurrencyField + `) DO UPDATE SET
+			` + commands.ExchangeRateRateField + ` = EXCLUDED.` + commands.ExchangeRateRateField + `,
+			` + commands.ExchangeRateUpdatedAtField + ` = now()`)
+
+	for _, rate := range rates {
+		builder = builder.Values(rate.Base, rate.Quote, rate.Rate)
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build upsert query")
+		return errors.Wrap(err, "failed to build query")
+	}
+
+	_, err = r.client.Conn(ctx).Exec(ctx, sql, args...)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute upsert query")
+		return errors.Wrap(err, "failed to save exchange rates")
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package idempotency
+
+import (
+	"context"
+	"subscription_service/infrastructure/postgres"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+)
+
+type idempotencyRepo struct {
+	client *postgres.Client
+	logger logger.Logger
+}
+
+type IdempotencyRepository interface {
+	Insert(ctx context.Context, record entities.IdempotencyRecord) error
+	SelectByKey(ctx context.Context, key string) (entities.IdempotencyRecord, error)
+}
+
+func NewIdempotencyRepository(client *postgres.Client, logger logger.Logger) IdempotencyRepository {
+	return &idempotencyRepo{
+		client: client,
+		logger: logger,
+	}
+}
@@ -0,0 +1,53 @@
+package idempotency
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+	"subscription_service/internal/usecases"
+)
+
+// Insert stores the record, taking over the key only if its previous record
+// has expired. A live record of the key, such as the one a concurrent request
+// has just committed, is left intact and ErrIdempotencyKeyInUse is returned.
+func (r *idempotencyRepo) Insert(ctx context.Context, record entities.IdempotencyRecord) error {
+	sql, args, err := r.client.Builder.
+		Insert(commands.IdempotencyKeyTable).
+		Columns(
+			commands.IdempotencyKeyField,
+			commands.IdempotencyKeyRequestHashField,
+			commands.IdempotencyKeyResponseField,
+			commands.IdempotencyKeyExpiresAtField,
+		).
+		Values(
+			record.Key,
+			record.RequestHash,
+			record.Response,
+			record.ExpiresAt,
+		).
+		Suffix(`ON CONFLICT (key) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash,
+			response = EXCLUDED.response,
+			created_at = now(),
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= now()`).
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build insert query")
+		return errors.Wrap(err, "failed to build query")
+	}
+
+	tag, err := r.client.Conn(ctx).Exec(ctx, sql, args...)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute insert query")
+		return errors.Wrap(err, "failed to insert idempotency key")
+	}
+
+	if tag.RowsAffected() == 0 {
+		r.logger.Error().Msg("Idempotency key is already in use")
+		return usecases.ErrIdempotencyKeyInUse
+	}
+
+	return nil
+}
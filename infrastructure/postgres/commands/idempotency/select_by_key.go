@@ -0,0 +1,46 @@
+package idempotency
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+	"subscription_service/internal/usecases"
+)
+
+// SelectByKey returns the live record for the key; expired records are treated as missing.
+func (r *idempotencyRepo) SelectByKey(ctx context.Context, key string) (entities.IdempotencyRecord, error) {
+	sql, args, err := r.client.Builder.
+		Select(
+			commands.IdempotencyKeyField,
+			commands.IdempotencyKeyRequestHashField,
+			commands.IdempotencyKeyResponseField,
+			commands.IdempotencyKeyExpiresAtField,
+		).
+		From(commands.IdempotencyKeyTable).
+		Where("key = ?", key).
+		Where("expires_at > now()").
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build select query")
+		return entities.IdempotencyRecord{}, errors.Wrap(err, "failed to build query")
+	}
+
+	var record entities.IdempotencyRecord
+	err = r.client.Conn(ctx).QueryRow(ctx, sql, args...).Scan(
+		&record.Key,
+		&record.RequestHash,
+		&record.Response,
+		&record.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.IdempotencyRecord{}, usecases.ErrEntityNotFound
+		}
+		r.logger.Error().Err(err).Msg("Failed to execute select query")
+		return entities.IdempotencyRecord{}, errors.Wrap(err, "failed to get idempotency key")
+	}
+
+	return record, nil
+}
@@ -0,0 +1,25 @@
+package pause
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"subscription_service/infrastructure/postgres"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+)
+
+type pauseRepo struct {
+	client *postgres.Client
+	logger logger.Logger
+}
+
+type PauseRepository interface {
+	SelectBySubscriptionIDs(ctx context.Context, subIDs []uuid.UUID) ([]entities.SubscriptionPause, error)
+}
+
+func NewPauseRepository(client *postgres.Client, logger logger.Logger) PauseRepository {
+	return &pauseRepo{
+		client: client,
+		logger: logger,
+	}
+}
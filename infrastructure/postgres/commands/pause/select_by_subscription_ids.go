@@ -0,0 +1,54 @@
+package pause
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+)
+
+// SelectBySubscriptionIDs returns the pauses of the given subscriptions ordered by start.
+func (r *pauseRepo) SelectBySubscriptionIDs(ctx context.Context, subIDs []uuid.UUID) ([]entities.SubscriptionPause, error) {
+	sql, args, err := r.client.Builder.
+		Select(
+			commands.SubscriptionPauseSubscriptionIDField,
+			commands.SubscriptionPausePausedFromField,
+			commands.SubscriptionPauseResumedAtField,
+		).
+		From(commands.SubscriptionPauseTable).
+		Where("subscription_id = ANY(?)", subIDs).
+		OrderBy(
+			commands.SubscriptionPauseSubscriptionIDField,
+			commands.SubscriptionPausePausedFromField,
+		).
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build select pauses query")
+		return nil, errors.Wrap(err, "failed to build query")
+	}
+
+	rows, err := r.client.Conn(ctx).Query(ctx, sql, args...)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute select pauses query")
+		return nil, errors.Wrap(err, "failed to get subscription pauses")
+	}
+	defer rows.Close()
+
+	var pauses []entities.SubscriptionPause
+	for rows.Next() {
+		var pause entities.SubscriptionPause
+		if err := rows.Scan(&pause.SubscriptionID, &pause.PausedFrom, &pause.ResumedAt); err != nil {
+			r.logger.Error().Err(err).Msg("Failed to scan subscription pause row")
+			return nil, errors.Wrap(err, "failed to scan subscription pause")
+		}
+		pauses = append(pauses, pause)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error().Err(err).Msg("Error iterating subscription pause rows")
+		return nil, errors.Wrap(err, "failed to get subscription pauses")
+	}
+
+	return pauses, nil
+}
@@ -0,0 +1,25 @@
+package price
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"subscription_service/infrastructure/postgres"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+)
+
+type priceRepo struct {
+	client *postgres.Client
+	logger logger.Logger
+}
+
+type PriceRepository interface {
+	SelectBySubscriptionIDs(ctx context.Context, subIDs []uuid.UUID) ([]entities.SubscriptionPrice, error)
+}
+
+func NewPriceRepository(client *postgres.Client, logger logger.Logger) PriceRepository {
+	return &priceRepo{
+		client: client,
+		logger: logger,
+	}
+}
@@ -0,0 +1,54 @@
+package price
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+)
+
+// SelectBySubscriptionIDs returns the price changes of the given subscriptions ordered by effective month.
+func (r *priceRepo) SelectBySubscriptionIDs(ctx context.Context, subIDs []uuid.UUID) ([]entities.SubscriptionPrice, error) {
+	sql, args, err := r.client.Builder.
+		Select(
+			commands.SubscriptionPriceSubscriptionIDField,
+			commands.SubscriptionPricePriceField,
+			commands.SubscriptionPriceEffectiveFromField,
+		).
+		From(commands.SubscriptionPriceTable).
+		Where("subscription_id = ANY(?)", subIDs).
+		OrderBy(
+			commands.SubscriptionPriceSubscriptionIDField,
+			commands.SubscriptionPriceEffectiveFromField,
+		).
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build select prices query")
+		return nil, errors.Wrap(err, "failed to build query")
+	}
+
+	rows, err := r.client.Conn(ctx).Query(ctx, sql, args...)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute select prices query")
+		return nil, errors.Wrap(err, "failed to get subscription prices")
+	}
+	defer rows.Close()
+
+	var prices []entities.SubscriptionPrice
+	for rows.Next() {
+		var price entities.SubscriptionPrice
+		if err := rows.Scan(&price.SubscriptionID, &price.Price, &price.EffectiveFrom); err != nil {
+			r.logger.Error().Err(err).Msg("Failed to scan subscription price row")
+			return nil, errors.Wrap(err, "failed to scan subscription price")
+		}
+		prices = append(prices, price)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error().Err(err).Msg("Error iterating subscription price rows")
+		return nil, errors.Wrap(err, "failed to get subscription prices")
+	}
+
+	return prices, nil
+}
@@ -0,0 +1,59 @@
+package subscription
+
+import (
+	"context"
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+	"subscription_service/internal/usecases"
+)
+
+// AddPrice adds a price change to the price history of the subscription.
+func (r *subRepo) AddPrice(ctx context.Context, price entities.SubscriptionPrice) error {
+	sql, args, err := r.client.Builder.
+		Insert(commands.SubscriptionPriceTable).
+		Columns(
+			commands.SubscriptionPriceSubscriptionIDField,
+			commands.SubscriptionPriceEffectiveFromField,
+			commands.SubscriptionPricePriceField,
+		).
+		Values(
+			price.SubscriptionID,
+			price.EffectiveFrom,
+			price.Price,
+		).
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build insert query")
+		return errors.Wrap(err, "failed to build query")
+	}
+
+	changed := &relatedRow{
+		key: "price",
+		query: squirrel.
+			Select("to_jsonb("+commands.SubscriptionPriceTable+")").
+			From(commands.SubscriptionPriceTable).
+			Where(commands.SubscriptionPriceSubscriptionIDField+" = ?", price.SubscriptionID).
+			Where(commands.SubscriptionPriceEffectiveFromField+" = ?", price.EffectiveFrom),
+	}
+
+	return r.auditedWith(ctx, entities.AuditActionPrice, price.SubscriptionID.String(), changed, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				switch pgErr.Code {
+				case uniqueViolationCode:
+					return usecases.ErrEntityAlreadyExists
+				case foreignKeyViolationCode:
+					return usecases.ErrEntityNotFound
+				}
+			}
+			r.logger.Error().Err(err).Msg("Failed to execute insert query")
+			return errors.Wrap(err, "failed to insert subscription price")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,144 @@
+package subscription
+
+import (
+	"context"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+	"subscription_service/internal/usecases"
+)
+
+// snapshotColumn renders the whole subscription row, deleted_at included, as
+// the JSON kept in the audit log.
+const snapshotColumn = "to_jsonb(" + commands.SubscriptionTable + ")"
+
+// relatedRow is a row of another table that a change of the subscription
+// affects, such as a pause or a price. query selects it as JSON, and the
+// audit snapshots keep it under key next to the subscription columns.
+type relatedRow struct {
+	key   string
+	query squirrel.SelectBuilder
+}
+
+// audited runs write in a transaction, or a savepoint of the ambient one,
+// together with the audit entry of the change, so neither is stored without
+// the other. The row is locked and captured before the write and captured
+// again after it.
+func (r *subRepo) audited(ctx context.Context, action, subID string, write func(tx pgx.Tx) error) error {
+	return r.auditedWith(ctx, action, subID, nil, write)
+}
+
+// auditedWith is audited whose snapshots also hold the related row, if any.
+func (r *subRepo) auditedWith(ctx context.Context, action, subID string, related *relatedRow, write func(tx pgx.Tx) error) error {
+	tx, err := r.client.Begin(ctx)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to begin transaction")
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op once committed
+
+	before, err := r.snapshot(ctx, tx, subID, related)
+	if err != nil {
+		return err
+	}
+
+	if err := write(tx); err != nil {
+		return err
+	}
+
+	after, err := r.snapshot(ctx, tx, subID, related)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(subID)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Invalid subscription id")
+		return errors.Wrap(usecases.ErrInvalidUUID, "failed to parse subscription id")
+	}
+
+	entry := entities.AuditEntry{SubscriptionID: id, Action: action, Before: before, After: after}
+	if err := r.insertAudit(ctx, tx, entry); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.logger.Error().Err(err).Msg("Failed to commit transaction")
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+// snapshot locks the subscription row and returns it as JSON, together with
+// the related row if one is given, or nil if there is no such subscription.
+func (r *subRepo) snapshot(ctx context.Context, tx pgx.Tx, subID string, related *relatedRow) ([]byte, error) {
+	column := squirrel.Expr(snapshotColumn)
+	if related != nil {
+		query, args, err := related.query.ToSql()
+		if err != nil {
+			r.logger.Error().Err(err).Msg("Failed to build related row query")
+			return nil, errors.Wrap(err, "failed to build query")
+		}
+		column = squirrel.Expr(snapshotColumn+" || jsonb_build_object('"+related.key+"', ("+query+"))", args...)
+	}
+
+	sql, args, err := r.client.Builder.
+		Select().
+		Column(column).
+		From(commands.SubscriptionTable).
+		Where("id = ?", subID).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build snapshot query")
+		return nil, errors.Wrap(err, "failed to build query")
+	}
+
+	var row []byte
+	err = tx.QueryRow(ctx, sql, args...).Scan(&row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Msg("Failed to execute snapshot query")
+		return nil, errors.Wrap(err, "failed to read subscription")
+	}
+
+	return row, nil
+}
+
+// insertAudit records entries stamped with the actor and request id of ctx.
+func (r *subRepo) insertAudit(ctx context.Context, tx pgx.Tx, entries ...entities.AuditEntry) error {
+	actor, requestID := usecases.AuditInfo(ctx)
+
+	builder := r.client.Builder.
+		Insert(commands.AuditLogTable).
+		Columns(
+			commands.AuditLogSubscriptionIDField,
+			commands.AuditLogActionField,
+			commands.AuditLogActorField,
+			commands.AuditLogRequestIDField,
+			commands.AuditLogBeforeField,
+			commands.AuditLogAfterField,
+		)
+	for _, entry := range entries {
+		builder = builder.Values(entry.SubscriptionID, entry.Action, actor, requestID, entry.Before, entry.After)
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build audit insert query")
+		return errors.Wrap(err, "failed to build query")
+	}
+
+	if _, err := tx.Exec(ctx, sql, args...); err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute audit insert query")
+		return errors.Wrap(err, "failed to record audit entry")
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package subscription
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+)
+
+func (r *subRepo) Count(ctx context.Context, filter entities.SubscriptionFilter) (int, error) {
+	sql, args, err := applyFilter(r.client.Builder.
+		Select("COUNT(*)").
+		From(commands.SubscriptionTable), filter).
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build count query")
+		return 0, errors.Wrap(err, "failed to build query")
+	}
+
+	var count int
+	err = r.client.Conn(ctx).QueryRow(ctx, sql, args...).Scan(&count)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute count query")
+		return 0, errors.Wrap(err, "failed to count subscriptions")
+	}
+
+	return count, nil
+}
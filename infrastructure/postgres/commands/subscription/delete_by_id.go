@@ -2,31 +2,39 @@ package subscription
 
 import (
 	"context"
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
 	"subscription_service/infrastructure/postgres/commands"
-	"subscription_service/internal/usecases"
+	"subscription_service/internal/entities"
 )
 
-func (r *subRepo) Delete(ctx context.Context, subID string) error {
+// Delete soft-deletes the subscription: it is hidden from every query until
+// restored or purged, and its billing history is kept.
+func (r *subRepo) Delete(ctx context.Context, subID string, version int) error {
 	sql, args, err := r.client.Builder.
-		Delete(commands.SubscriptionTable).
+		Update(commands.SubscriptionTable).
+		Set(commands.SubscriptionDeletedAtField, squirrel.Expr("now()")).
+		Set(commands.SubscriptionVersionField, squirrel.Expr(commands.SubscriptionVersionField+" + 1")).
 		Where("id = ?", subID).
+		Where("version = ?", version).
+		Where(notDeleted).
 		ToSql()
 	if err != nil {
 		r.logger.Error().Err(err).Msg("Failed to build delete query")
 		return errors.Wrap(err, "failed to build query")
 	}
 
-	result, err := r.client.Pool.Exec(ctx, sql, args...)
-	if err != nil {
-		r.logger.Error().Err(err).Msg("Failed to execute delete query")
-		return errors.Wrap(err, "failed to delete subscription")
-	}
-
-	if result.RowsAffected() == 0 {
-		r.logger.Error().Msg("Subscription not found")
-		return usecases.ErrEntityNotFound
-	}
+	return r.audited(ctx, entities.AuditActionDelete, subID, func(tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, sql, args...)
+		if err != nil {
+			r.logger.Error().Err(err).Msg("Failed to execute delete query")
+			return errors.Wrap(err, "failed to delete subscription")
+		}
 
-	return nil
+		if result.RowsAffected() == 0 {
+			return r.versionConflict(ctx, subID)
+		}
+		return nil
+	})
 }
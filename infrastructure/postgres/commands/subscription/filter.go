@@ -0,0 +1,118 @@
+package subscription
+
+import (
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+	"strings"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+	"subscription_service/internal/usecases"
+	"time"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func applyFilter(builder squirrel.SelectBuilder, filter entities.SubscriptionFilter) squirrel.SelectBuilder {
+	builder = builder.Where(notDeleted)
+	if filter.UserID != nil {
+		builder = builder.Where("user_id = ?", *filter.UserID)
+	}
+	if filter.ServiceName != nil {
+		builder = builder.Where("service_name = ?", *filter.ServiceName)
+	}
+	if filter.ServiceNamePrefix != nil {
+		builder = builder.Where("service_name ILIKE ?", likeEscaper.Replace(*filter.ServiceNamePrefix)+"%")
+	}
+	if filter.MinPrice != nil {
+		builder = builder.Where(commands.SubscriptionCurrentPriceExpression+" >= ?", *filter.MinPrice)
+	}
+	if filter.MaxPrice != nil {
+		builder = builder.Where(commands.SubscriptionCurrentPriceExpression+" <= ?", *filter.MaxPrice)
+	}
+	if filter.ActiveTo != nil {
+		builder = builder.Where("start_date <= ?", *filter.ActiveTo)
+	}
+	if filter.ActiveFrom != nil {
+		builder = builder.Where("(end_date >= ? OR end_date IS NULL)", *filter.ActiveFrom)
+	}
+	if len(filter.Statuses) > 0 {
+		status, args := statusExpression(filter.StatusDate)
+		builder = builder.Where(squirrel.Expr(status+" = ANY(?)", append(args, filter.Statuses)...))
+	}
+
+	return builder
+}
+
+// statusExpression computes the status of a subscription on the given day
+// following the same rules as entities.Subscription.Status.
+func statusExpression(day time.Time) (string, []any) {
+	pausedFrom := lastPauseColumn(commands.SubscriptionPausePausedFromField)
+	resumedAt := lastPauseColumn(commands.SubscriptionPauseResumedAtField)
+
+	sql := "(CASE" +
+		" WHEN start_date > ? THEN '" + entities.StatusScheduled + "'" +
+		" WHEN end_date < ? THEN (CASE WHEN cancelled_at IS NULL THEN '" + entities.StatusExpired + "' ELSE '" + entities.StatusCancelled + "' END)" +
+		" WHEN " + pausedFrom + " <= ? AND (" + resumedAt + " IS NULL OR " + resumedAt + " > ?) THEN '" + entities.StatusPaused + "'" +
+		" WHEN ?::date BETWEEN trial_start_date AND trial_end_date THEN '" + entities.StatusTrial + "'" +
+		" WHEN cancelled_at IS NOT NULL AND end_date IS NOT NULL THEN '" + entities.StatusCancelled + "'" +
+		" ELSE '" + entities.StatusActive + "' END)"
+
+	return sql, []any{day, day, day, day, day}
+}
+
+// applySort orders by a whitelisted column, breaking ties on id so that
+// the order is stable.
+func applySort(builder squirrel.SelectBuilder, sort entities.SubscriptionSort) (squirrel.SelectBuilder, error) {
+	field, ok := commands.SubscriptionSortFields[sort.Field]
+	if !ok {
+		return builder, errors.Wrapf(usecases.ErrInvalidFilter, "unknown sort field %q", sort.Field)
+	}
+
+	direction := "ASC"
+	if sort.Desc {
+		direction = "DESC"
+	}
+
+	if field.Expression == commands.SubscriptionIDField {
+		return builder.OrderBy(fmt.Sprintf("%s %s", field.Expression, direction)), nil
+	}
+
+	return builder.OrderBy(
+		fmt.Sprintf("%s %s", field.Expression, direction),
+		fmt.Sprintf("%s %s", commands.SubscriptionIDField, direction),
+	), nil
+}
+
+// applyPage sorts with applySort, which keeps pages stable between
+// requests, and applies either keyset or offset pagination.
+func applyPage(builder squirrel.SelectBuilder, sort entities.SubscriptionSort, page entities.Page) (squirrel.SelectBuilder, error) {
+	builder, err := applySort(builder, sort)
+	if err != nil {
+		return builder, err
+	}
+
+	comparison := ">"
+	if sort.Desc {
+		comparison = "<"
+	}
+
+	if page.After != nil {
+		field := commands.SubscriptionSortFields[sort.Field]
+		if field.Expression == commands.SubscriptionIDField {
+			builder = builder.Where(fmt.Sprintf("%s %s ?", commands.SubscriptionIDField, comparison), page.After.ID)
+		} else {
+			builder = builder.Where(
+				fmt.Sprintf("(%s, %s) %s (CAST(? AS %s), ?)", field.Expression, commands.SubscriptionIDField, comparison, field.Type),
+				page.After.Value, page.After.ID,
+			)
+		}
+	}
+
+	builder = builder.Limit(uint64(page.Limit))
+	if page.After == nil {
+		builder = builder.Offset(uint64(page.Offset))
+	}
+
+	return builder, nil
+}
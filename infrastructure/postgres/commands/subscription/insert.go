@@ -2,41 +2,59 @@ package subscription
 
 import (
 	"context"
+	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
 	"subscription_service/infrastructure/postgres/commands"
 	"subscription_service/internal/entities"
 )
 
+var insertColumns = []string{
+	commands.SubscriptionIDField,
+	commands.SubscriptionServiceNameField,
+	commands.SubscriptionPriceField,
+	commands.SubscriptionUserIDField,
+	commands.SubscriptionStartDateField,
+	commands.SubscriptionEndDateField,
+	commands.SubscriptionVersionField,
+	commands.SubscriptionCurrencyField,
+	commands.SubscriptionBillingPeriodField,
+	commands.SubscriptionTrialStartField,
+	commands.SubscriptionTrialEndField,
+}
+
+// insertValues lists the values of sub in the order of insertColumns.
+func insertValues(sub *entities.Subscription) []any {
+	return []any{
+		sub.ID,
+		sub.ServiceName,
+		sub.Price,
+		sub.UserID,
+		sub.StartDate,
+		sub.EndDate,
+		sub.Version,
+		sub.Currency,
+		sub.BillingPeriod,
+		sub.TrialStartDate,
+		sub.TrialEndDate,
+	}
+}
+
 func (r *subRepo) Insert(ctx context.Context, sub *entities.Subscription) error {
 	sql, args, err := r.client.Builder.
 		Insert(commands.SubscriptionTable).
-		Columns(
-			commands.SubscriptionIDField,
-			commands.SubscriptionServiceNameField,
-			commands.SubscriptionPriceField,
-			commands.SubscriptionUserIDField,
-			commands.SubscriptionStartDateField,
-			commands.SubscriptionEndDateField,
-		).
-		Values(
-			sub.ID,
-			sub.ServiceName,
-			sub.Price,
-			sub.UserID,
-			sub.StartDate,
-			sub.EndDate,
-		).
+		Columns(insertColumns...).
+		Values(insertValues(sub)...).
 		ToSql()
 	if err != nil {
 		r.logger.Error().Err(err).Msg("Failed to build insert query")
 		return errors.Wrap(err, "failed to build query")
 	}
 
-	_, err = r.client.Pool.Exec(ctx, sql, args...)
-	if err != nil {
-		r.logger.Error().Err(err).Msg("Failed to execute insert query")
-		return errors.Wrap(err, "failed to insert subscription")
-	}
-
-	return nil
+	return r.audited(ctx, entities.AuditActionCreate, sub.ID.String(), func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			r.logger.Error().Err(err).Msg("Failed to execute insert query")
+			return errors.Wrap(err, "failed to insert subscription")
+		}
+		return nil
+	})
 }
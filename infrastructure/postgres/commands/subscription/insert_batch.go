@@ -0,0 +1,72 @@
+package subscription
+
+import (
+	"context"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+	"subscription_service/internal/usecases"
+)
+
+// InsertBatch copies subs into the table in one round trip and records their
+// creation in the audit log. Either all of them are stored or none is.
+func (r *subRepo) InsertBatch(ctx context.Context, subs []entities.Subscription) error {
+	ids := make([]uuid.UUID, 0, len(subs))
+	for _, sub := range subs {
+		ids = append(ids, sub.ID)
+	}
+
+	actor, requestID := usecases.AuditInfo(ctx)
+	auditSQL, auditArgs, err := r.client.Builder.
+		Insert(commands.AuditLogTable).
+		Columns(
+			commands.AuditLogSubscriptionIDField,
+			commands.AuditLogActionField,
+			commands.AuditLogActorField,
+			commands.AuditLogRequestIDField,
+			commands.AuditLogAfterField,
+		).
+		Select(squirrel.
+			Select(commands.SubscriptionIDField).
+			Column("?", entities.AuditActionCreate).
+			Column("?", actor).
+			Column("?", requestID).
+			Column(snapshotColumn).
+			From(commands.SubscriptionTable).
+			Where("id = ANY(?)", ids)).
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build audit insert query")
+		return errors.Wrap(err, "failed to build query")
+	}
+
+	tx, err := r.client.Begin(ctx)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to begin transaction")
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op once committed
+
+	rows := pgx.CopyFromSlice(len(subs), func(i int) ([]any, error) {
+		return insertValues(&subs[i]), nil
+	})
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{commands.SubscriptionTable}, insertColumns, rows); err != nil {
+		r.logger.Error().Err(err).Msg("Failed to copy subscriptions")
+		return errors.Wrap(err, "failed to insert subscriptions")
+	}
+
+	if _, err := tx.Exec(ctx, auditSQL, auditArgs...); err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute audit insert query")
+		return errors.Wrap(err, "failed to record audit entries")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.logger.Error().Err(err).Msg("Failed to commit transaction")
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package subscription
+
+import (
+	"context"
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+)
+
+func (r *subRepo) Patch(ctx context.Context, subID string, version int, changes entities.SubscriptionChanges) (int, error) {
+	builder := r.client.Builder.
+		Update(commands.SubscriptionTable).
+		Set(commands.SubscriptionVersionField, squirrel.Expr(commands.SubscriptionVersionField+" + 1")).
+		Where("id = ?", subID).
+		Where(notDeleted).
+		Where("version = ?", version).
+		Suffix("RETURNING " + commands.SubscriptionVersionField)
+
+	if changes.ServiceName != nil {
+		builder = builder.Set(commands.SubscriptionServiceNameField, *changes.ServiceName)
+	}
+	if changes.Currency != nil {
+		builder = builder.Set(commands.SubscriptionCurrencyField, *changes.Currency)
+	}
+	if changes.BillingPeriod != nil {
+		builder = builder.Set(commands.SubscriptionBillingPeriodField, *changes.BillingPeriod)
+	}
+	if changes.UserID != nil {
+		builder = builder.Set(commands.SubscriptionUserIDField, *changes.UserID)
+	}
+	if changes.StartDate != nil {
+		builder = builder.Set(commands.SubscriptionStartDateField, *changes.StartDate)
+	}
+	if changes.EndDate != nil {
+		builder = builder.Set(commands.SubscriptionEndDateField, *changes.EndDate)
+	}
+	if changes.ClearEndDate {
+		builder = builder.Set(commands.SubscriptionEndDateField, nil)
+	}
+	if changes.TrialStartDate != nil {
+		builder = builder.Set(commands.SubscriptionTrialStartField, *changes.TrialStartDate)
+	}
+	if changes.TrialEndDate != nil {
+		builder = builder.Set(commands.SubscriptionTrialEndField, *changes.TrialEndDate)
+	}
+	if changes.ClearTrial {
+		builder = builder.
+			Set(commands.SubscriptionTrialStartField, nil).
+			Set(commands.SubscriptionTrialEndField, nil)
+	}
+	if changes.CancelledAt != nil {
+		builder = builder.Set(commands.SubscriptionCancelledAtField, *changes.CancelledAt)
+	}
+	if changes.CancelReason != nil {
+		builder = builder.Set(commands.SubscriptionCancelReasonField, *changes.CancelReason)
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build patch query")
+		return 0, errors.Wrap(err, "failed to build query")
+	}
+
+	var newVersion int
+	err = r.audited(ctx, entities.AuditActionUpdate, subID, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, sql, args...).Scan(&newVersion)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return r.versionConflict(ctx, subID)
+			}
+			r.logger.Error().Err(err).Msg("Failed to execute patch query")
+			return errors.Wrap(err, "failed to patch subscription")
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return newVersion, nil
+}
@@ -0,0 +1,68 @@
+package subscription
+
+import (
+	"context"
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+	"subscription_service/internal/usecases"
+)
+
+const (
+	uniqueViolationCode     = "23505"
+	foreignKeyViolationCode = "23503"
+)
+
+// lastPause is the latest pause of the subscription, the one pausing and
+// resuming change.
+func lastPause(subID string) *relatedRow {
+	return &relatedRow{
+		key: "pause",
+		query: squirrel.
+			Select("to_jsonb("+commands.SubscriptionPauseTable+")").
+			From(commands.SubscriptionPauseTable).
+			Where(commands.SubscriptionPauseSubscriptionIDField+" = ?", subID).
+			OrderBy(commands.SubscriptionPausePausedFromField + " DESC").
+			Limit(1),
+	}
+}
+
+// Pause starts a pause of the subscription.
+func (r *subRepo) Pause(ctx context.Context, pause entities.SubscriptionPause) error {
+	sql, args, err := r.client.Builder.
+		Insert(commands.SubscriptionPauseTable).
+		Columns(
+			commands.SubscriptionPauseSubscriptionIDField,
+			commands.SubscriptionPausePausedFromField,
+		).
+		Values(
+			pause.SubscriptionID,
+			pause.PausedFrom,
+		).
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build insert query")
+		return errors.Wrap(err, "failed to build query")
+	}
+
+	subID := pause.SubscriptionID.String()
+	return r.auditedWith(ctx, entities.AuditActionPause, subID, lastPause(subID), func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, sql, args...); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				switch pgErr.Code {
+				case uniqueViolationCode:
+					return usecases.ErrEntityAlreadyExists
+				case foreignKeyViolationCode:
+					return usecases.ErrEntityNotFound
+				}
+			}
+			r.logger.Error().Err(err).Msg("Failed to execute insert query")
+			return errors.Wrap(err, "failed to insert subscription pause")
+		}
+		return nil
+	})
+}
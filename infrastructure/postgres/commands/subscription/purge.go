@@ -0,0 +1,65 @@
+package subscription
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+	"time"
+)
+
+// Purge permanently removes subscriptions soft-deleted before deletedBefore,
+// together with their prices and pauses, and returns how many were removed.
+// Each removed row is recorded in the audit log.
+func (r *subRepo) Purge(ctx context.Context, deletedBefore time.Time) (int, error) {
+	sql, args, err := r.client.Builder.
+		Delete(commands.SubscriptionTable).
+		Where(commands.SubscriptionDeletedAtField+" < ?", deletedBefore).
+		Suffix("RETURNING " + commands.SubscriptionIDField + ", " + snapshotColumn).
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build purge query")
+		return 0, errors.Wrap(err, "failed to build query")
+	}
+
+	tx, err := r.client.Begin(ctx)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to begin transaction")
+		return 0, errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // no-op once committed
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute purge query")
+		return 0, errors.Wrap(err, "failed to purge subscriptions")
+	}
+
+	var entries []entities.AuditEntry
+	for rows.Next() {
+		entry := entities.AuditEntry{Action: entities.AuditActionPurge}
+		if err := rows.Scan(&entry.SubscriptionID, &entry.Before); err != nil {
+			rows.Close()
+			r.logger.Error().Err(err).Msg("Failed to scan purged subscription")
+			return 0, errors.Wrap(err, "failed to scan purged subscription")
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute purge query")
+		return 0, errors.Wrap(err, "failed to purge subscriptions")
+	}
+
+	if len(entries) > 0 {
+		if err := r.insertAudit(ctx, tx, entries...); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		r.logger.Error().Err(err).Msg("Failed to commit transaction")
+		return 0, errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return len(entries), nil
+}
@@ -0,0 +1,40 @@
+package subscription
+
+import (
+	"context"
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+	"subscription_service/internal/usecases"
+)
+
+// Restore brings back a soft-deleted subscription.
+func (r *subRepo) Restore(ctx context.Context, subID string) error {
+	sql, args, err := r.client.Builder.
+		Update(commands.SubscriptionTable).
+		Set(commands.SubscriptionDeletedAtField, nil).
+		Set(commands.SubscriptionVersionField, squirrel.Expr(commands.SubscriptionVersionField+" + 1")).
+		Where("id = ?", subID).
+		Where(commands.SubscriptionDeletedAtField + " IS NOT NULL").
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build restore query")
+		return errors.Wrap(err, "failed to build query")
+	}
+
+	return r.audited(ctx, entities.AuditActionRestore, subID, func(tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, sql, args...)
+		if err != nil {
+			r.logger.Error().Err(err).Msg("Failed to execute restore query")
+			return errors.Wrap(err, "failed to restore subscription")
+		}
+
+		if result.RowsAffected() == 0 {
+			r.logger.Error().Msg("Deleted subscription not found")
+			return usecases.ErrEntityNotFound
+		}
+		return nil
+	})
+}
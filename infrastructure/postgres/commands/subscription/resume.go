@@ -0,0 +1,40 @@
+package subscription
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+	"subscription_service/internal/usecases"
+	"time"
+)
+
+// Resume closes the open pause of the subscription.
+func (r *subRepo) Resume(ctx context.Context, subID uuid.UUID, resumedAt time.Time) error {
+	sql, args, err := r.client.Builder.
+		Update(commands.SubscriptionPauseTable).
+		Set(commands.SubscriptionPauseResumedAtField, resumedAt).
+		Where("subscription_id = ?", subID).
+		Where("resumed_at IS NULL").
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build resume query")
+		return errors.Wrap(err, "failed to build query")
+	}
+
+	return r.auditedWith(ctx, entities.AuditActionResume, subID.String(), lastPause(subID.String()), func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, sql, args...)
+		if err != nil {
+			r.logger.Error().Err(err).Msg("Failed to execute resume query")
+			return errors.Wrap(err, "failed to resume subscription")
+		}
+
+		if tag.RowsAffected() == 0 {
+			r.logger.Error().Msg("Open pause not found")
+			return usecases.ErrEntityNotFound
+		}
+		return nil
+	})
+}
@@ -7,26 +7,24 @@ import (
 	"subscription_service/internal/entities"
 )
 
-func (r *subRepo) SelectAll(ctx context.Context, limit, offset int) ([]entities.Subscription, error) {
-	sql, args, err := r.client.Builder.
-		Select(
-			commands.SubscriptionIDField,
-			commands.SubscriptionServiceNameField,
-			commands.SubscriptionPriceField,
-			commands.SubscriptionUserIDField,
-			commands.SubscriptionStartDateField,
-			commands.SubscriptionEndDateField,
-		).
-		From(commands.SubscriptionTable).
-		Limit(uint64(limit)).
-		Offset(uint64(offset)).
-		ToSql()
+func (r *subRepo) SelectAll(ctx context.Context, filter entities.SubscriptionFilter, sort entities.SubscriptionSort, page entities.Page) ([]entities.Subscription, error) {
+	builder := applyFilter(r.client.Builder.
+		Select(subscriptionColumns...).
+		From(commands.SubscriptionTable), filter)
+
+	builder, err := applyPage(builder, sort, page)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to apply sort")
+		return nil, err
+	}
+
+	sql, args, err := builder.ToSql()
 	if err != nil {
 		r.logger.Error().Err(err).Msg("Failed to build select all query")
 		return nil, errors.Wrap(err, "failed to build query")
 	}
 
-	rows, err := r.client.Pool.Query(ctx, sql, args...)
+	rows, err := r.client.Conn(ctx).Query(ctx, sql, args...)
 	if err != nil {
 		r.logger.Error().Err(err).Msg("Failed to execute select all query")
 		return nil, errors.Wrap(err, "failed to get subscriptions")
@@ -36,15 +34,7 @@ func (r *subRepo) SelectAll(ctx context.Context, limit, offset int) ([]entities.
 	var subscriptions []entities.Subscription
 	for rows.Next() {
 		var sub entities.Subscription
-		err := rows.Scan(
-			&sub.ID,
-			&sub.ServiceName,
-			&sub.Price,
-			&sub.UserID,
-			&sub.StartDate,
-			&sub.EndDate, // *time.Time — работает корректно с NULL
-		)
-		if err != nil {
+		if err := scanSubscription(rows, &sub); err != nil {
 			r.logger.Error().Err(err).Msg("Failed to scan subscription row")
 			return nil, errors.Wrap(err, "failed to scan subscription")
 		}
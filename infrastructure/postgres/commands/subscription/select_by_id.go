@@ -11,16 +11,10 @@ import (
 
 func (r *subRepo) SelectByID(ctx context.Context, subID string) (entities.Subscription, error) {
 	sql, args, err := r.client.Builder.
-		Select(
-			commands.SubscriptionIDField,
-			commands.SubscriptionServiceNameField,
-			commands.SubscriptionPriceField,
-			commands.SubscriptionUserIDField,
-			commands.SubscriptionStartDateField,
-			commands.SubscriptionEndDateField,
-		).
+		Select(subscriptionColumns...).
 		From(commands.SubscriptionTable).
 		Where("id = ?", subID).
+		Where(notDeleted).
 		ToSql()
 	if err != nil {
 		r.logger.Error().Err(err).Msg("Failed to build select query")
@@ -28,14 +22,7 @@ func (r *subRepo) SelectByID(ctx context.Context, subID string) (entities.Subscr
 	}
 
 	var sub entities.Subscription
-	err = r.client.Pool.QueryRow(ctx, sql, args...).Scan(
-		&sub.ID,
-		&sub.ServiceName,
-		&sub.Price,
-		&sub.UserID,
-		&sub.StartDate,
-		&sub.EndDate, // сразу в *time.Time
-	)
+	err = scanSubscription(r.client.Conn(ctx).QueryRow(ctx, sql, args...), &sub)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			r.logger.Error().Msg("Subscription not found")
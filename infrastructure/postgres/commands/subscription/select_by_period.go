@@ -0,0 +1,55 @@
+package subscription
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+	"time"
+)
+
+func (r *subRepo) SelectByPeriod(ctx context.Context, startPeriod, endPeriod time.Time, userID, serviceName *string) ([]entities.Subscription, error) {
+	builder := r.client.Builder.
+		Select(subscriptionColumns...).
+		From(commands.SubscriptionTable).
+		Where("start_date <= ?", endPeriod).
+		Where("(end_date >= ? OR end_date IS NULL)", startPeriod).
+		Where(notDeleted)
+
+	if userID != nil {
+		builder = builder.Where("user_id = ?", *userID)
+	}
+	if serviceName != nil {
+		builder = builder.Where("service_name = ?", *serviceName)
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build select by period query")
+		return nil, errors.Wrap(err, "failed to build query")
+	}
+
+	rows, err := r.client.Conn(ctx).Query(ctx, sql, args...)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute select by period query")
+		return nil, errors.Wrap(err, "failed to get subscriptions")
+	}
+	defer rows.Close()
+
+	var subscriptions []entities.Subscription
+	for rows.Next() {
+		var sub entities.Subscription
+		if err := scanSubscription(rows, &sub); err != nil {
+			r.logger.Error().Err(err).Msg("Failed to scan subscription row")
+			return nil, errors.Wrap(err, "failed to scan subscription")
+		}
+		subscriptions = append(subscriptions, sub)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error().Err(err).Msg("Error iterating subscription rows")
+		return nil, errors.Wrap(err, "failed to get subscriptions")
+	}
+
+	return subscriptions, nil
+}
@@ -0,0 +1,50 @@
+package subscription
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+	"time"
+)
+
+// SelectTrialsEnding returns subscriptions whose trial ends within [from, to]
+// and which stay active after it, i.e. are about to be charged.
+func (r *subRepo) SelectTrialsEnding(ctx context.Context, from, to time.Time) ([]entities.Subscription, error) {
+	sql, args, err := r.client.Builder.
+		Select(subscriptionColumns...).
+		From(commands.SubscriptionTable).
+		Where(commands.SubscriptionTrialEndField+" BETWEEN ? AND ?", from, to).
+		Where("(end_date > "+commands.SubscriptionTrialEndField+" OR end_date IS NULL)").
+		Where(notDeleted).
+		OrderBy(commands.SubscriptionTrialEndField, commands.SubscriptionIDField).
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build select trials ending query")
+		return nil, errors.Wrap(err, "failed to build query")
+	}
+
+	rows, err := r.client.Conn(ctx).Query(ctx, sql, args...)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute select trials ending query")
+		return nil, errors.Wrap(err, "failed to get subscriptions")
+	}
+	defer rows.Close()
+
+	var subscriptions []entities.Subscription
+	for rows.Next() {
+		var sub entities.Subscription
+		if err := scanSubscription(rows, &sub); err != nil {
+			r.logger.Error().Err(err).Msg("Failed to scan subscription row")
+			return nil, errors.Wrap(err, "failed to scan subscription")
+		}
+		subscriptions = append(subscriptions, sub)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error().Err(err).Msg("Error iterating subscription rows")
+		return nil, errors.Wrap(err, "failed to get subscriptions")
+	}
+
+	return subscriptions, nil
+}
@@ -0,0 +1,52 @@
+package subscription
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+)
+
+// Stream passes every subscription matching the filter to fn in sort order.
+// Rows are read from the connection one by one as fn consumes them, so the
+// result set is never held in memory; an error from fn stops the query.
+func (r *subRepo) Stream(ctx context.Context, filter entities.SubscriptionFilter, sort entities.SubscriptionSort, fn func(entities.Subscription) error) error {
+	builder, err := applySort(applyFilter(r.client.Builder.
+		Select(subscriptionColumns...).
+		From(commands.SubscriptionTable), filter), sort)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to apply sort")
+		return err
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build stream query")
+		return errors.Wrap(err, "failed to build query")
+	}
+
+	rows, err := r.client.Conn(ctx).Query(ctx, sql, args...)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute stream query")
+		return errors.Wrap(err, "failed to get subscriptions")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var sub entities.Subscription
+		if err := scanSubscription(rows, &sub); err != nil {
+			r.logger.Error().Err(err).Msg("Failed to scan subscription row")
+			return errors.Wrap(err, "failed to scan subscription")
+		}
+		if err := fn(sub); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error().Err(err).Msg("Error iterating subscription rows")
+		return errors.Wrap(err, "failed to get subscriptions")
+	}
+
+	return nil
+}
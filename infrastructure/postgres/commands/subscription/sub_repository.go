@@ -2,12 +2,49 @@ package subscription
 
 import (
 	"context"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
 	"subscription_service/infrastructure/postgres"
+	"subscription_service/infrastructure/postgres/commands"
 	"subscription_service/internal/entities"
+	"subscription_service/internal/usecases"
 	"subscription_service/pkg/logger"
 	"time"
 )
 
+var subscriptionColumns = []string{
+	commands.SubscriptionIDField,
+	commands.SubscriptionServiceNameField,
+	commands.SubscriptionPriceField,
+	commands.SubscriptionUserIDField,
+	commands.SubscriptionStartDateField,
+	commands.SubscriptionEndDateField,
+	commands.SubscriptionVersionField,
+	commands.SubscriptionCurrencyField,
+	commands.SubscriptionBillingPeriodField,
+	commands.SubscriptionTrialStartField,
+	commands.SubscriptionTrialEndField,
+	commands.SubscriptionCancelledAtField,
+	commands.SubscriptionCancelReasonField,
+	lastPauseColumn(commands.SubscriptionPausePausedFromField),
+	lastPauseColumn(commands.SubscriptionPauseResumedAtField),
+	commands.SubscriptionCurrentPriceExpression,
+}
+
+// lastPauseColumn selects a field of the latest pause of each subscription,
+// which is enough to tell whether it is paused now.
+func lastPauseColumn(field string) string {
+	return "(SELECT " + field +
+		" FROM " + commands.SubscriptionPauseTable +
+		" WHERE " + commands.SubscriptionPauseSubscriptionIDField + " = " + commands.SubscriptionTable + "." + commands.SubscriptionIDField +
+		" ORDER BY " + commands.SubscriptionPausePausedFromField + " DESC LIMIT 1)"
+}
+
+// notDeleted hides soft-deleted subscriptions; every read and write but
+// Restore and Purge applies it.
+const notDeleted = commands.SubscriptionDeletedAtField + " IS NULL"
+
 type subRepo struct {
 	client *postgres.Client
 	logger logger.Logger
@@ -15,11 +52,21 @@ type subRepo struct {
 
 type SubRepository interface {
 	Insert(ctx context.Context, sub *entities.Subscription) error
-	Delete(ctx context.Context, subID string) error
+	InsertBatch(ctx context.Context, subs []entities.Subscription) error
+	Delete(ctx context.Context, subID string, version int) error
 	Update(ctx context.Context, sub *entities.Subscription) error
+	Patch(ctx context.Context, subID string, version int, changes entities.SubscriptionChanges) (int, error)
 	SelectByID(ctx context.Context, subID string) (entities.Subscription, error)
-	SelectAll(ctx context.Context, limit, offset int) ([]entities.Subscription, error)
-	CalculateTotalCost(ctx context.Context, startPeriod, endPeriod time.Time, userID *string, serviceName *string) (int, error)
+	SelectAll(ctx context.Context, filter entities.SubscriptionFilter, sort entities.SubscriptionSort, page entities.Page) ([]entities.Subscription, error)
+	Count(ctx context.Context, filter entities.SubscriptionFilter) (int, error)
+	Stream(ctx context.Context, filter entities.SubscriptionFilter, sort entities.SubscriptionSort, fn func(entities.Subscription) error) error
+	SelectByPeriod(ctx context.Context, startPeriod, endPeriod time.Time, userID *string, serviceName *string) ([]entities.Subscription, error)
+	SelectTrialsEnding(ctx context.Context, from, to time.Time) ([]entities.Subscription, error)
+	Restore(ctx context.Context, subID string) error
+	Pause(ctx context.Context, pause entities.SubscriptionPause) error
+	Resume(ctx context.Context, subID uuid.UUID, resumedAt time.Time) error
+	AddPrice(ctx context.Context, price entities.SubscriptionPrice) error
+	Purge(ctx context.Context, deletedBefore time.Time) (int, error)
 }
 
 func NewSubRepository(client *postgres.Client, logger logger.Logger) SubRepository {
@@ -28,3 +75,66 @@ func NewSubRepository(client *postgres.Client, logger logger.Logger) SubReposito
 		logger: logger,
 	}
 }
+
+// scanSubscription reads a row selected with subscriptionColumns.
+func scanSubscription(row pgx.Row, sub *entities.Subscription) error {
+	var pausedFrom, resumedAt *time.Time
+	err := row.Scan(
+		&sub.ID,
+		&sub.ServiceName,
+		&sub.Price,
+		&sub.UserID,
+		&sub.StartDate,
+		&sub.EndDate, // *time.Time — работает корректно с NULL
+		&sub.Version,
+		&sub.Currency,
+		&sub.BillingPeriod,
+		&sub.TrialStartDate,
+		&sub.TrialEndDate,
+		&sub.CancelledAt,
+		&sub.CancelReason,
+		&pausedFrom,
+		&resumedAt,
+		&sub.CurrentPrice,
+	)
+	if err != nil {
+		return err
+	}
+
+	if pausedFrom != nil {
+		sub.LastPause = &entities.SubscriptionPause{SubscriptionID: sub.ID, PausedFrom: *pausedFrom, ResumedAt: resumedAt}
+	}
+
+	return nil
+}
+
+// versionConflict tells apart a missing subscription from one whose version
+// moved on, after a conditional write matched no rows.
+func (r *subRepo) versionConflict(ctx context.Context, subID string) error {
+	sql, args, err := r.client.Builder.
+		Select("1").
+		From(commands.SubscriptionTable).
+		Where("id = ?", subID).
+		Where(notDeleted).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build exists query")
+		return errors.Wrap(err, "failed to build query")
+	}
+
+	var exists bool
+	if err := r.client.Conn(ctx).QueryRow(ctx, sql, args...).Scan(&exists); err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute exists query")
+		return errors.Wrap(err, "failed to check subscription")
+	}
+
+	if !exists {
+		r.logger.Error().Msg("Subscription not found")
+		return usecases.ErrEntityNotFound
+	}
+
+	r.logger.Error().Msg("Subscription version mismatch")
+	return usecases.ErrVersionMismatch
+}
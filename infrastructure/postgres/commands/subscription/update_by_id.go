@@ -2,37 +2,46 @@ package subscription
 
 import (
 	"context"
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
 	"subscription_service/infrastructure/postgres/commands"
 	"subscription_service/internal/entities"
-	"subscription_service/internal/usecases"
 )
 
+// Update replaces the subscription but its initial price, which changes only
+// through the price history.
 func (r *subRepo) Update(ctx context.Context, sub *entities.Subscription) error {
 	sql, args, err := r.client.Builder.
 		Update(commands.SubscriptionTable).
 		Set(commands.SubscriptionServiceNameField, sub.ServiceName).
-		Set(commands.SubscriptionPriceField, sub.Price).
+		Set(commands.SubscriptionCurrencyField, sub.Currency).
+		Set(commands.SubscriptionBillingPeriodField, sub.BillingPeriod).
 		Set(commands.SubscriptionUserIDField, sub.UserID).
 		Set(commands.SubscriptionStartDateField, sub.StartDate).
 		Set(commands.SubscriptionEndDateField, sub.EndDate).
+		Set(commands.SubscriptionTrialStartField, sub.TrialStartDate).
+		Set(commands.SubscriptionTrialEndField, sub.TrialEndDate).
+		Set(commands.SubscriptionVersionField, squirrel.Expr(commands.SubscriptionVersionField+" + 1")).
 		Where("id = ?", sub.ID).
+		Where(notDeleted).
+		Where("version = ?", sub.Version).
+		Suffix("RETURNING " + commands.SubscriptionVersionField).
 		ToSql()
 	if err != nil {
 		r.logger.Error().Err(err).Msg("Failed to build update query")
 		return errors.Wrap(err, "failed to build query")
 	}
 
-	result, err := r.client.Pool.Exec(ctx, sql, args...)
-	if err != nil {
-		r.logger.Error().Err(err).Msg("Failed to execute update query")
-		return errors.Wrap(err, "failed to update subscription")
-	}
-
-	if result.RowsAffected() == 0 {
-		r.logger.Error().Msg("Subscription not found")
-		return usecases.ErrEntityNotFound
-	}
-
-	return nil
+	return r.audited(ctx, entities.AuditActionUpdate, sub.ID.String(), func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, sql, args...).Scan(&sub.Version)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return r.versionConflict(ctx, sub.ID.String())
+			}
+			r.logger.Error().Err(err).Msg("Failed to execute update query")
+			return errors.Wrap(err, "failed to update subscription")
+		}
+		return nil
+	})
 }
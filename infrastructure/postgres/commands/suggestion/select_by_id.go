@@ -0,0 +1,40 @@
+package suggestion
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+	"subscription_service/internal/usecases"
+)
+
+// SelectByID returns a suggestion of the user, locking it until the end of
+// the ambient transaction so that it is accepted at most once.
+func (r *suggestionRepo) SelectByID(ctx context.Context, userID, suggestionID uuid.UUID) (entities.SubscriptionSuggestion, error) {
+	sql, args, err := r.client.Builder.
+		Select(suggestionColumns...).
+		From(commands.SuggestionTable).
+		Where("id = ?", suggestionID).
+		Where("user_id = ?", userID).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build select suggestion query")
+		return entities.SubscriptionSuggestion{}, errors.Wrap(err, "failed to build query")
+	}
+
+	var suggestion entities.SubscriptionSuggestion
+	err = scanSuggestion(r.client.Conn(ctx).QueryRow(ctx, sql, args...), &suggestion)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.logger.Error().Msg("Subscription suggestion not found")
+			return entities.SubscriptionSuggestion{}, usecases.ErrEntityNotFound
+		}
+		r.logger.Error().Err(err).Msg("Failed to execute select suggestion query")
+		return entities.SubscriptionSuggestion{}, errors.Wrap(err, "failed to get subscription suggestion")
+	}
+
+	return suggestion, nil
+}
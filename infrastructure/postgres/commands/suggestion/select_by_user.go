@@ -0,0 +1,48 @@
+package suggestion
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+)
+
+// SelectByUser returns the pending suggestions of the user ordered by service name.
+func (r *suggestionRepo) SelectByUser(ctx context.Context, userID uuid.UUID) ([]entities.SubscriptionSuggestion, error) {
+	sql, args, err := r.client.Builder.
+		Select(suggestionColumns...).
+		From(commands.SuggestionTable).
+		Where("user_id = ?", userID).
+		Where("status = ?", entities.SuggestionPending).
+		OrderBy(commands.SuggestionServiceNameField, commands.SuggestionIDField).
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build select suggestions query")
+		return nil, errors.Wrap(err, "failed to build query")
+	}
+
+	rows, err := r.client.Conn(ctx).Query(ctx, sql, args...)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute select suggestions query")
+		return nil, errors.Wrap(err, "failed to get subscription suggestions")
+	}
+	defer rows.Close()
+
+	var suggestions []entities.SubscriptionSuggestion
+	for rows.Next() {
+		var suggestion entities.SubscriptionSuggestion
+		if err := scanSuggestion(rows, &suggestion); err != nil {
+			r.logger.Error().Err(err).Msg("Failed to scan suggestion row")
+			return nil, errors.Wrap(err, "failed to scan subscription suggestion")
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error().Err(err).Msg("Error iterating suggestion rows")
+		return nil, errors.Wrap(err, "failed to get subscription suggestions")
+	}
+
+	return suggestions, nil
+}
@@ -0,0 +1,41 @@
+package suggestion
+
+import (
+	"context"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+	"subscription_service/internal/usecases"
+)
+
+// SetStatus records the decision on a pending suggestion and, for an
+// accepted one, the subscription created from it.
+func (r *suggestionRepo) SetStatus(ctx context.Context, suggestionID uuid.UUID, status string, subID *uuid.UUID) error {
+	sql, args, err := r.client.Builder.
+		Update(commands.SuggestionTable).
+		Set(commands.SuggestionStatusField, status).
+		Set(commands.SuggestionSubscriptionIDField, subID).
+		Set(commands.SuggestionUpdatedAtField, squirrel.Expr("now()")).
+		Where("id = ?", suggestionID).
+		Where("status = ?", entities.SuggestionPending).
+		ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build set status query")
+		return errors.Wrap(err, "failed to build query")
+	}
+
+	tag, err := r.client.Conn(ctx).Exec(ctx, sql, args...)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute set status query")
+		return errors.Wrap(err, "failed to update subscription suggestion")
+	}
+
+	if tag.RowsAffected() == 0 {
+		r.logger.Error().Msg("Pending subscription suggestion not found")
+		return usecases.ErrInvalidStatus
+	}
+
+	return nil
+}
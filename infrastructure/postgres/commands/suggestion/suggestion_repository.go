@@ -0,0 +1,65 @@
+package suggestion
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"subscription_service/infrastructure/postgres"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+)
+
+var suggestionColumns = []string{
+	commands.SuggestionIDField,
+	commands.SuggestionUserIDField,
+	commands.SuggestionMerchantField,
+	commands.SuggestionServiceNameField,
+	commands.SuggestionPriceField,
+	commands.SuggestionCurrencyField,
+	commands.SuggestionBillingPeriodField,
+	commands.SuggestionFirstChargeDateField,
+	commands.SuggestionLastChargeDateField,
+	commands.SuggestionChargesField,
+	commands.SuggestionStatusField,
+	commands.SuggestionSubscriptionIDField,
+	commands.SuggestionCreatedAtField,
+}
+
+type suggestionRepo struct {
+	client *postgres.Client
+	logger logger.Logger
+}
+
+type SuggestionRepository interface {
+	Upsert(ctx context.Context, suggestions []entities.SubscriptionSuggestion) error
+	SelectByUser(ctx context.Context, userID uuid.UUID) ([]entities.SubscriptionSuggestion, error)
+	SelectByID(ctx context.Context, userID, suggestionID uuid.UUID) (entities.SubscriptionSuggestion, error)
+	SetStatus(ctx context.Context, suggestionID uuid.UUID, status string, subID *uuid.UUID) error
+}
+
+func NewSuggestionRepository(client *postgres.Client, logger logger.Logger) SuggestionRepository {
+	return &suggestionRepo{
+		client: client,
+		logger: logger,
+	}
+}
+
+// scanSuggestion reads a row selected with suggestionColumns.
+func scanSuggestion(row pgx.Row, suggestion *entities.SubscriptionSuggestion) error {
+	return row.Scan(
+		&suggestion.ID,
+		&suggestion.UserID,
+		&suggestion.Merchant,
+		&suggestion.ServiceName,
+		&suggestion.Price,
+		&suggestion.Currency,
+		&suggestion.BillingPeriod,
+		&suggestion.FirstChargeDate,
+		&suggestion.LastChargeDate,
+		&suggestion.Charges,
+		&suggestion.Status,
+		&suggestion.SubscriptionID,
+		&suggestion.CreatedAt,
+	)
+}
@@ -0,0 +1,66 @@
+package suggestion
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/infrastructure/postgres/commands"
+	"subscription_service/internal/entities"
+)
+
+// Upsert saves the suggestions in one statement. A suggestion already found
+// in an earlier statement is refreshed when the new one reaches a later
+// charge, keeping the earliest first charge; accepted and dismissed
+// suggestions are left as they are.
+func (r *suggestionRepo) Upsert(ctx context.Context, suggestions []entities.SubscriptionSuggestion) error {
+	builder := r.client.Builder.
+		Insert(commands.SuggestionTable).
+		Columns(
+			commands.SuggestionUserIDField,
+			commands.SuggestionMerchantField,
+			commands.SuggestionServiceNameField,
+			commands.SuggestionPriceField,
+			commands.SuggestionCurrencyField,
+			commands.SuggestionBillingPeriodField,
+			commands.SuggestionFirstChargeDateField,
+			commands.SuggestionLastChargeDateField,
+			commands.SuggestionChargesField,
+		).
+		Suffix(`ON CONFLICT (user_id, merchant, currency) DO UPDATE SET
+			service_name = EXCLUDED.service_name,
+			price = EXCLUDED.price,
+			billing_period = EXCLUDED.billing_period,
+			first_charge_date = LEAST(subscription_suggestions.first_charge_date, EXCLUDED.first_charge_date),
+			last_charge_date = EXCLUDED.last_charge_date,
+			charges = GREATEST(subscription_suggestions.charges, EXCLUDED.charges),
+			updated_at = now()
+		WHERE subscription_suggestions.status = 'pending'
+			AND EXCLUDED.last_charge_date >= subscription_suggestions.last_charge_date`)
+
+	for _, suggestion := range suggestions {
+		builder = builder.Values(
+			suggestion.UserID,
+			suggestion.Merchant,
+			suggestion.ServiceName,
+			suggestion.Price,
+			suggestion.Currency,
+			suggestion.BillingPeriod,
+			suggestion.FirstChargeDate,
+			suggestion.LastChargeDate,
+			suggestion.Charges,
+		)
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to build upsert query")
+		return errors.Wrap(err, "failed to build query")
+	}
+
+	_, err = r.client.Conn(ctx).Exec(ctx, sql, args...)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to execute upsert query")
+		return errors.Wrap(err, "failed to save subscription suggestions")
+	}
+
+	return nil
+}
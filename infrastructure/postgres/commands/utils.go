@@ -1,11 +1,95 @@
 package commands
 
 const (
-	SubscriptionTable            = "subscriptions"
-	SubscriptionIDField          = "id"
-	SubscriptionServiceNameField = "service_name"
-	SubscriptionPriceField       = "price"
-	SubscriptionUserIDField      = "user_id"
-	SubscriptionStartDateField   = "start_date"
-	SubscriptionEndDateField     = "end_date"
+	SubscriptionTable              = "subscriptions"
+	SubscriptionIDField            = "id"
+	SubscriptionServiceNameField   = "service_name"
+	SubscriptionPriceField         = "price"
+	SubscriptionUserIDField        = "user_id"
+	SubscriptionStartDateField     = "start_date"
+	SubscriptionEndDateField       = "end_date"
+	SubscriptionVersionField       = "version"
+	SubscriptionCurrencyField      = "currency"
+	SubscriptionBillingPeriodField = "billing_period"
+	SubscriptionTrialStartField    = "trial_start_date"
+	SubscriptionTrialEndField      = "trial_end_date"
+	SubscriptionCancelledAtField   = "cancelled_at"
+	SubscriptionCancelReasonField  = "cancel_reason"
+	SubscriptionDeletedAtField     = "deleted_at"
+
+	IdempotencyKeyTable            = "idempotency_keys"
+	IdempotencyKeyField            = "key"
+	IdempotencyKeyRequestHashField = "request_hash"
+	IdempotencyKeyResponseField    = "response"
+	IdempotencyKeyExpiresAtField   = "expires_at"
+
+	SubscriptionPriceTable               = "subscription_prices"
+	SubscriptionPriceSubscriptionIDField = "subscription_id"
+	SubscriptionPriceEffectiveFromField  = "effective_from"
+	SubscriptionPricePriceField          = "price"
+
+	SubscriptionPauseTable               = "subscription_pauses"
+	SubscriptionPauseSubscriptionIDField = "subscription_id"
+	SubscriptionPausePausedFromField     = "paused_from"
+	SubscriptionPauseResumedAtField      = "resumed_at"
+
+	AuditLogTable               = "audit_log"
+	AuditLogIDField             = "id"
+	AuditLogSubscriptionIDField = "subscription_id"
+	AuditLogActionField         = "action"
+	AuditLogActorField          = "actor"
+	AuditLogRequestIDField      = "request_id"
+	AuditLogBeforeField         = "before"
+	AuditLogAfterField          = "after"
+	AuditLogCreatedAtField      = "created_at"
+
+	ExchangeRateTable              = "exchange_rates"
+	ExchangeRateBaseCurrencyField  = "base_currency"
+	ExchangeRateQuoteCurrencyField = "quote_currency"
+	ExchangeRateRateField          = "rate"
+	ExchangeRateUpdatedAtField     = "updated_at"
+
+	SuggestionTable                = "subscription_suggestions"
+	SuggestionIDField              = "id"
+	SuggestionUserIDField          = "user_id"
+	SuggestionMerchantField        = "merchant"
+	SuggestionServiceNameField     = "service_name"
+	SuggestionPriceField           = "price"
+	SuggestionCurrencyField        = "currency"
+	SuggestionBillingPeriodField   = "billing_period"
+	SuggestionFirstChargeDateField = "first_charge_date"
+	SuggestionLastChargeDateField  = "last_charge_date"
+	SuggestionChargesField         = "charges"
+	SuggestionStatusField          = "status"
+	SuggestionSubscriptionIDField  = "subscription_id"
+	SuggestionCreatedAtField       = "created_at"
+	SuggestionUpdatedAtField       = "updated_at"
 )
+
+// SortField is an ORDER BY expression together with the SQL type keyset
+// cursor values are cast to when compared against it.
+type SortField struct {
+	Expression string
+	Type       string
+}
+
+// SubscriptionCurrentPriceExpression selects the latest price change already
+// in effect, or the initial price if there is none.
+var SubscriptionCurrentPriceExpression = "COALESCE((SELECT " + SubscriptionPricePriceField +
+	" FROM " + SubscriptionPriceTable +
+	" WHERE " + SubscriptionPriceSubscriptionIDField + " = " + SubscriptionTable + "." + SubscriptionIDField +
+	" AND " + SubscriptionPriceEffectiveFromField + " <= CURRENT_DATE" +
+	" ORDER BY " + SubscriptionPriceEffectiveFromField + " DESC LIMIT 1), " +
+	SubscriptionTable + "." + SubscriptionPriceField + ")"
+
+// SubscriptionSortFields whitelists the columns a subscription list can be ordered by.
+// end_date is coalesced so that open-ended subscriptions have a comparable position,
+// and price is the one in effect today, as in responses.
+var SubscriptionSortFields = map[string]SortField{
+	"id":           {Expression: SubscriptionIDField, Type: "uuid"},
+	"service_name": {Expression: SubscriptionServiceNameField, Type: "text"},
+	"price":        {Expression: SubscriptionCurrentPriceExpression, Type: "integer"},
+	"user_id":      {Expression: SubscriptionUserIDField, Type: "uuid"},
+	"start_date":   {Expression: SubscriptionStartDateField, Type: "date"},
+	"end_date":     {Expression: "COALESCE(" + SubscriptionEndDateField + ", 'infinity'::date)", Type: "date"},
+}
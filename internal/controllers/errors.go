@@ -5,4 +5,5 @@ import "errors"
 var (
 	ErrDataBindError           = errors.New("wrong data format")
 	ErrInvalidPaginationParams = errors.New("invalid pagination parameters")
+	ErrPreconditionRequired    = errors.New("If-Match header is required")
 )
@@ -0,0 +1,70 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type acceptSuggestionController struct {
+	useCase usecases.AcceptSuggestionUseCase
+	logger  logger.Logger
+}
+
+func NewAcceptSuggestionController(
+	handler *gin.Engine,
+	useCase usecases.AcceptSuggestionUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &acceptSuggestionController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.POST("/users/:user_id/suggestions/:suggestion_id/accept", ct.AcceptSuggestion, middleware.HandleErrors)
+}
+
+// AcceptSuggestion godoc
+// @Summary Принятие предложения подписки
+// @Description Создает подписку из предложения. Подписка начинается с первого найденного списания, поля запроса заменяют предложенные значения
+// @Tags suggestions
+// @Accept json
+// @Produce json
+// @Param user_id path string true "ID пользователя"
+// @Param suggestion_id path string true "ID предложения"
+// @Param suggestion body requests.AcceptSuggestion false "структура запроса"
+// @Success 201 {object} responses.SubResponse
+// @Header 201 {string} ETag "версия подписки"
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 404 {object} string "предложение не найдено"
+// @Failure 409 {object} string "предложение уже принято или отклонено"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /users/{user_id}/suggestions/{suggestion_id}/accept [post]
+func (as *acceptSuggestionController) AcceptSuggestion(c *gin.Context) {
+	userID, suggestionID := c.Param("user_id"), c.Param("suggestion_id")
+	if userID == "" || suggestionID == "" {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	var req requests.AcceptSuggestion
+	if err := bindOptionalJSON(c, &req); err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	response, err := as.useCase.AcceptSuggestion(c, userID, suggestionID, req)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to accept suggestion"))
+		return
+	}
+
+	setETag(c, response.Version)
+	c.JSON(http.StatusCreated, response)
+}
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type addSubPriceController struct {
+	useCase usecases.AddSubPriceUseCase
+	logger  logger.Logger
+}
+
+func NewAddSubPriceController(
+	handler *gin.Engine,
+	useCase usecases.AddSubPriceUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &addSubPriceController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.POST("/subscriptions/:sub_id/prices", ct.AddSubscriptionPrice, middleware.HandleErrors)
+}
+
+// AddSubscriptionPrice godoc
+// @Summary Изменение цены подписки
+// @Description Добавляет новую цену подписки, действующую с указанного месяца. Цены прошлых месяцев не меняются
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Param sub_id path string true "path format"
+// @Param price body requests.SubPriceRequest true "структура запроса"
+// @Success 201 {object} responses.SubPrice
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 404 {object} string "подписка не найдена"
+// @Failure 409 {object} string "цена с этого месяца уже задана"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /subscriptions/{sub_id}/prices [post]
+func (ap *addSubPriceController) AddSubscriptionPrice(c *gin.Context) {
+	subId := c.Param("sub_id")
+	if subId == "" {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	var req requests.SubPriceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := ap.useCase.AddSubscriptionPrice(c, subId, req)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to add subscription price"))
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
@@ -0,0 +1,34 @@
+package http
+
+import (
+	"subscription_service/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	actorHeader     = "X-Actor"
+	requestIDHeader = "X-Request-ID"
+	// anonymousActor is recorded for requests without the X-Actor header.
+	anonymousActor = "anonymous"
+)
+
+// auditInfo puts who makes the request and its id into the request context,
+// so that the changes it makes are attributed in the audit log. A request
+// id is generated unless the client sent one, and is echoed back.
+func auditInfo(c *gin.Context) {
+	actor := c.GetHeader(actorHeader)
+	if actor == "" {
+		actor = anonymousActor
+	}
+
+	requestID := c.GetHeader(requestIDHeader)
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+	c.Header(requestIDHeader, requestID)
+
+	c.Request = c.Request.WithContext(usecases.WithAuditInfo(c.Request.Context(), actor, requestID))
+	c.Next()
+}
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type batchSubController struct {
+	useCase usecases.BatchSubUseCase
+	logger  logger.Logger
+}
+
+func NewBatchSubController(
+	handler *gin.Engine,
+	useCase usecases.BatchSubUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &batchSubController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.POST("/subscriptions/batch", ct.BatchSubscriptions, middleware.HandleErrors)
+}
+
+// BatchSubscriptions godoc
+// @Summary Пакетное создание, изменение и удаление подписок
+// @Description Создает, заменяет и удаляет подписки одним запросом, до 5000 элементов каждого вида. Каждый элемент проверяется отдельно. При atomic=true применяются либо все элементы, либо ни один; иначе каждый элемент применяется независимо. Результат каждого элемента содержит HTTP-статус, который он получил бы отдельным запросом
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Param batch body requests.SubBatchRequest true "структура запроса"
+// @Success 200 {object} responses.SubBatch "все элементы применены"
+// @Success 207 {object} responses.SubBatch "часть элементов (или при atomic=true все) не применена"
+// @Failure 400 {object} string "некорректный формат запроса или пустой пакет"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /subscriptions/batch [post]
+func (bs *batchSubController) BatchSubscriptions(c *gin.Context) {
+	var req requests.SubBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := bs.useCase.BatchSubscriptions(c, req)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to apply batch"))
+		return
+	}
+
+	status := http.StatusOK
+	for i := range response.Results {
+		item := &response.Results[i]
+		if item.Err == nil {
+			item.Status = successStatus(item.Operation)
+			continue
+		}
+
+		status = http.StatusMultiStatus
+		item.Status = middleware.StatusOf(item.Err)
+		item.Error = item.Err.Error()
+		if item.Status == http.StatusInternalServerError {
+			bs.logger.Err(item.Err).Error().Msgf("Unexpected batch item error: ")
+			item.Error = "Internal server error"
+		}
+	}
+
+	c.JSON(status, response)
+}
+
+func successStatus(operation string) int {
+	if operation == responses.BatchOperationCreate {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
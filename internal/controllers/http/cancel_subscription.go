@@ -0,0 +1,70 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type cancelSubController struct {
+	useCase usecases.CancelSubUseCase
+	logger  logger.Logger
+}
+
+func NewCancelSubController(
+	handler *gin.Engine,
+	useCase usecases.CancelSubUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &cancelSubController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.POST("/subscriptions/:sub_id/cancel", ct.CancelSubscription, middleware.HandleErrors)
+}
+
+// CancelSubscription godoc
+// @Summary Отмена подписки
+// @Description Устанавливает end_date на последний день текущего или указанного месяца и сохраняет время и причину отмены
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Param sub_id path string true "path format"
+// @Param cancel body requests.CancelSubscription false "структура запроса"
+// @Success 200 {object} responses.SubResponse
+// @Header 200 {string} ETag "новая версия подписки"
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 404 {object} string "подписка не найдена"
+// @Failure 409 {object} string "подписка уже отменена или истекла"
+// @Failure 412 {object} string "подписку изменили во время отмены"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /subscriptions/{sub_id}/cancel [post]
+func (cs *cancelSubController) CancelSubscription(c *gin.Context) {
+	subId := c.Param("sub_id")
+	if subId == "" {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	var req requests.CancelSubscription
+	if err := bindOptionalJSON(c, &req); err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	response, err := cs.useCase.CancelSubscription(c, subId, req)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to cancel subscription"))
+		return
+	}
+
+	setETag(c, response.Version)
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,63 @@
+package http
+
+import (
+	"bytes"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+const xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+type costReportController struct {
+	useCase usecases.CostReportUseCase
+	logger  logger.Logger
+}
+
+func NewCostReportController(
+	handler *gin.Engine,
+	useCase usecases.CostReportUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &costReportController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.POST("/subscriptions/total/xlsx", ct.CostReport, middleware.HandleErrors)
+}
+
+// CostReport godoc
+// @Summary Отчет о стоимости подписок в XLSX
+// @Description Считает стоимость подписок так же, как расчет общей стоимости, и возвращает книгу XLSX из двух листов.
+// @Description Лист «Подписки» содержит по строке на подписку (сервис, пользователь, число оплаченных месяцев, сумма), лист «Итоги» — суммы по сервисам и месяцам. Параметр group_by не поддерживается, период — не длиннее 16382 месяцев.
+// @Tags subscriptions
+// @Accept json
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param request body requests.CalculateTotalCost true "структура запроса"
+// @Success 200 {file} file "книга XLSX"
+// @Failure      400 {object} string "некорректный формат запроса, передан group_by или слишком длинный период"
+// @Failure      500 {object} string "внутренняя ошибка сервера"
+// @Router /subscriptions/total/xlsx [post]
+func (cr *costReportController) CostReport(c *gin.Context) {
+	var req requests.CalculateTotalCost
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	var report bytes.Buffer
+	if err := cr.useCase.CostReport(c, req, &report); err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to build cost report"))
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="subscriptions-total.xlsx"`)
+	c.Data(http.StatusOK, xlsxContentType, report.Bytes())
+}
@@ -11,6 +11,11 @@ import (
 	"subscription_service/pkg/logger"
 )
 
+const (
+	idempotencyKeyHeader    = "Idempotency-Key"
+	maxIdempotencyKeyLength = 255
+)
+
 type createSubController struct {
 	useCase usecases.CreateSubUseCase
 	logger  logger.Logger
@@ -36,9 +41,13 @@ func NewCreateSubController(
 // @Tags subscriptions
 // @Accept json
 // @Produce json
+// @Param Idempotency-Key header string false "ключ идемпотентности, повторный запрос с тем же ключом возвращает сохраненный ответ"
 // @Param subscription body requests.SubRequest true "структура запроса"
 // @Success 201 {object} responses.SubResponse
+// @Header 201 {string} ETag "версия подписки"
 // @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 409 {object} string "одновременный запрос с тем же ключом идемпотентности"
+// @Failure 422 {object} string "ключ идемпотентности использован с другим запросом"
 // @Failure 500 {object} string "внутренняя ошибка сервера"
 // @Router /subscriptions [post]
 func (cs *createSubController) CreateSubscription(c *gin.Context) {
@@ -48,11 +57,18 @@ func (cs *createSubController) CreateSubscription(c *gin.Context) {
 		return
 	}
 
-	response, err := cs.useCase.CreateSubscription(c, req)
+	idempotencyKey := c.GetHeader(idempotencyKeyHeader)
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		middleware.AddGinError(c, errors.Wrap(controllers.ErrDataBindError, "idempotency key is too long"))
+		return
+	}
+
+	response, err := cs.useCase.CreateSubscription(c, req, idempotencyKey)
 	if err != nil {
 		middleware.AddGinError(c, errors.Wrap(err, "failed to create subscription"))
 		return
 	}
 
+	setETag(c, response.Version)
 	c.JSON(http.StatusCreated, response)
 }
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/usecases"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	dateFormatQuery = "date_format"
+	// isoDateProfile is the Accept profile that asks for YYYY-MM-DD dates,
+	// e.g. Accept: application/json; profile="iso-date".
+	isoDateProfile = "iso-date"
+)
+
+// dateFormat puts the date format requested with the date_format query
+// parameter or the Accept profile into the request context.
+func dateFormat(c *gin.Context) {
+	format := c.Query(dateFormatQuery)
+	switch format {
+	case "":
+		format = acceptedDateFormat(c.GetHeader("Accept"))
+	case usecases.DateFormatMonth, usecases.DateFormatISO:
+	default:
+		c.AbortWithStatusJSON(http.StatusBadRequest, controllers.ErrDataBindError.Error())
+		return
+	}
+
+	c.Request = c.Request.WithContext(usecases.WithDateFormat(c.Request.Context(), format))
+	c.Next()
+}
+
+func acceptedDateFormat(accept string) string {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		_, params, err := mime.ParseMediaType(mediaRange)
+		if err != nil {
+			continue
+		}
+		if params["profile"] == isoDateProfile {
+			return usecases.DateFormatISO
+		}
+	}
+
+	return usecases.DateFormatMonth
+}
@@ -31,13 +31,16 @@ func NewDeleteSubController(
 
 // DeleteSubscription godoc
 // @Summary Удаление подписки
-// @Description Удаление подписки по ID
+// @Description Удаление подписки по ID. Удалённую подписку можно восстановить до очистки через POST /subscriptions/{sub_id}/restore
 // @Tags subscriptions
 // @Produce json
 // @Param sub_id path string true "path format"
+// @Param If-Match header string true "ETag текущей версии подписки; значение * не принимается"
 // @Success 200
 // @Failure 	 400 {object} string "некорректный формат запроса"
 // @Failure      404 {object} string "подписка не найдена"
+// @Failure      412 {object} string "подписка была изменена"
+// @Failure      428 {object} string "не передан заголовок If-Match или передано значение *"
 // @Failure      500 {object} string "внутренняя ошибка сервера
 // @Router /subscriptions/{sub_id} [delete]
 func (ds *deleteSubController) DeleteSubscription(c *gin.Context) {
@@ -47,7 +50,13 @@ func (ds *deleteSubController) DeleteSubscription(c *gin.Context) {
 		return
 	}
 
-	if err := ds.useCase.DeleteSubscription(c, subId); err != nil {
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	if err := ds.useCase.DeleteSubscription(c, subId, version); err != nil {
 		middleware.AddGinError(c, errors.Wrap(err, "failed to delete subscription"))
 		return
 	}
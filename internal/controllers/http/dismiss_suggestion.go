@@ -0,0 +1,58 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type dismissSuggestionController struct {
+	useCase usecases.DismissSuggestionUseCase
+	logger  logger.Logger
+}
+
+func NewDismissSuggestionController(
+	handler *gin.Engine,
+	useCase usecases.DismissSuggestionUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &dismissSuggestionController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.POST("/users/:user_id/suggestions/:suggestion_id/dismiss", ct.DismissSuggestion, middleware.HandleErrors)
+}
+
+// DismissSuggestion godoc
+// @Summary Отклонение предложения подписки
+// @Description Скрывает предложение. Повторная загрузка выписки не возвращает отклоненное предложение
+// @Tags suggestions
+// @Produce json
+// @Param user_id path string true "ID пользователя"
+// @Param suggestion_id path string true "ID предложения"
+// @Success 200
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 404 {object} string "предложение не найдено"
+// @Failure 409 {object} string "предложение уже принято или отклонено"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /users/{user_id}/suggestions/{suggestion_id}/dismiss [post]
+func (ds *dismissSuggestionController) DismissSuggestion(c *gin.Context) {
+	userID, suggestionID := c.Param("user_id"), c.Param("suggestion_id")
+	if userID == "" || suggestionID == "" {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	if err := ds.useCase.DismissSuggestion(c, userID, suggestionID); err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to dismiss suggestion"))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,36 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"strconv"
+	"strings"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/usecases"
+)
+
+func setETag(c *gin.Context, version int) {
+	c.Header("ETag", strconv.Quote(strconv.Itoa(version)))
+}
+
+// ifMatchVersion reads the subscription version a client expects from the
+// If-Match header. Only strong ETags issued by setETag can ever match. "*"
+// would let a client overwrite changes it hasn't seen, so it counts as no
+// precondition at all.
+func ifMatchVersion(c *gin.Context) (int, error) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return 0, controllers.ErrPreconditionRequired
+	}
+
+	tag, err := strconv.Unquote(header)
+	if err != nil {
+		return 0, usecases.ErrVersionMismatch
+	}
+
+	version, err := strconv.Atoi(tag)
+	if err != nil {
+		return 0, usecases.ErrVersionMismatch
+	}
+
+	return version, nil
+}
@@ -0,0 +1,109 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+var exportContentTypes = map[string]string{
+	usecases.ExportFormatCSV:   "text/csv; charset=utf-8",
+	usecases.ExportFormatJSONL: "application/x-ndjson",
+}
+
+type exportSubsController struct {
+	useCase usecases.ExportSubsUseCase
+	logger  logger.Logger
+}
+
+func NewExportSubsController(
+	handler *gin.Engine,
+	useCase usecases.ExportSubsUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &exportSubsController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.GET("/subscriptions/export", ct.ExportSubscriptions, middleware.HandleErrors)
+}
+
+// ExportSubscriptions godoc
+// @Summary Выгрузка подписок
+// @Description Потоково выгружает все подписки, подходящие под фильтры списка подписок, в CSV с заголовком или в JSON Lines (по объекту подписки на строку).
+// @Description Строки читаются из базы по мере отправки клиенту, поэтому выгрузка не ограничена по размеру. Если ошибка произошла после начала выгрузки, ответ обрывается.
+// @Tags subscriptions
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Param format query string false "Формат выгрузки" Enums(csv, jsonl) default(csv)
+// @Param user_id query string false "ID пользователя"
+// @Param service_name query string false "Точное название сервиса"
+// @Param service_name_prefix query string false "Начало названия сервиса без учета регистра"
+// @Param min_price query int false "Минимальная цена, действующая сегодня"
+// @Param max_price query int false "Максимальная цена, действующая сегодня"
+// @Param active_at query string false "День (YYYY-MM-DD) или месяц (MM-YYYY), в котором подписка активна"
+// @Param status query string false "Статусы подписки на сегодня через запятую: scheduled, trial, active, paused, cancelled, expired"
+// @Param sort query string false "Поле сортировки" Enums(id, service_name, price, user_id, start_date, end_date) default(id)
+// @Param order query string false "Направление сортировки" Enums(asc, desc) default(asc)
+// @Success 200 {string} string "подписки в выбранном формате"
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /subscriptions/export [get]
+func (es *exportSubsController) ExportSubscriptions(c *gin.Context) {
+	var req requests.ExportSubscriptions
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	w := &exportWriter{c: c, contentType: exportContentTypes[req.Format], filename: "subscriptions." + req.Format}
+	if err := es.useCase.ExportSubscriptions(c, req, w); err != nil {
+		if w.started {
+			// the status is already sent, so the client only sees a cut-off body
+			es.logger.Error().Err(err).Msg("Export interrupted")
+			c.Abort()
+			return
+		}
+		middleware.AddGinError(c, errors.Wrap(err, "failed to export subscriptions"))
+		return
+	}
+
+	if !w.started {
+		w.writeHeader()
+	}
+}
+
+// exportWriter sends the response headers on the first write, so that
+// errors found before any row is ready still get a regular error response.
+type exportWriter struct {
+	c           *gin.Context
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.writeHeader()
+	}
+
+	n, err := w.c.Writer.Write(p)
+	w.c.Writer.Flush()
+
+	return n, err
+}
+
+func (w *exportWriter) writeHeader() {
+	w.started = true
+	w.c.Header("Content-Type", w.contentType)
+	w.c.Header("Content-Disposition", `attachment; filename="`+w.filename+`"`)
+	w.c.Status(http.StatusOK)
+	w.c.Writer.WriteHeaderNow()
+}
@@ -4,9 +4,9 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 	"net/http"
-	"strconv"
 	"subscription_service/internal/controllers"
 	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/controllers/requests"
 	"subscription_service/internal/usecases"
 	"subscription_service/pkg/logger"
 )
@@ -32,33 +32,59 @@ func NewGetListSubController(
 
 // GetListSubscriptions godoc
 // @Summary Получение списка подписок
-// @Description Возвращает список подписок с поддержкой пагинации
+// @Description Возвращает список подписок с поддержкой пагинации, фильтрации и сортировки.
+// @Description Если передан параметр cursor (пустой для первой страницы), используется курсорная пагинация.
+// @Description По умолчанию возвращается массив подписок, как в предыдущих версиях API. При envelope=true или курсорной пагинации
+// @Description ответ оборачивается в объект с общим количеством записей и ссылками на соседние страницы.
 // @Tags subscriptions
 // @Produce      json
 // @Param limit query int false "Количество подписок на странице" default(10)
 // @Param offset query int false "Смещение" default(0)
+// @Param cursor query string false "Курсор следующей страницы из next_cursor"
+// @Param user_id query string false "ID пользователя"
+// @Param service_name query string false "Точное название сервиса"
+// @Param service_name_prefix query string false "Начало названия сервиса без учета регистра"
+// @Param min_price query int false "Минимальная цена, действующая сегодня"
+// @Param max_price query int false "Максимальная цена, действующая сегодня"
+// @Param active_at query string false "День (YYYY-MM-DD) или месяц (MM-YYYY), в котором подписка активна"
+// @Param status query string false "Статусы подписки на сегодня через запятую: scheduled, trial, active, paused, cancelled, expired"
+// @Param sort query string false "Поле сортировки" Enums(id, service_name, price, user_id, start_date, end_date) default(id)
+// @Param order query string false "Направление сортировки" Enums(asc, desc) default(asc)
+// @Param envelope query bool false "Оборачивать ответ в объект с метаданными пагинации" default(false)
 // @Success      200 {object} []responses.SubResponse
+// @Success      200 {object} responses.SubList "при envelope=true или курсорной пагинации"
 // @Failure      400 {object} string "некорректный формат запроса"
 // @Failure      500 {object} string "внутренняя ошибка сервера"
 // @Router /subscriptions [get]
 func (gl *getListSubController) GetListSubscriptions(c *gin.Context) {
-	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
-	if err != nil || limit < 1 {
-		middleware.AddGinError(c, controllers.ErrInvalidPaginationParams)
+	var req requests.GetListSubscriptions
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
 		return
 	}
 
-	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
-	if err != nil || offset < 0 {
+	if req.Limit < 1 || req.Offset < 0 {
 		middleware.AddGinError(c, controllers.ErrInvalidPaginationParams)
 		return
 	}
 
-	response, err := gl.useCase.GetListSubscriptions(c, limit, offset)
+	// a cursor page is useless without next_cursor, so it is always wrapped
+	if req.Cursor != nil {
+		req.Envelope = true
+	}
+
+	response, err := gl.useCase.GetListSubscriptions(c, req)
 	if err != nil {
 		middleware.AddGinError(c, errors.Wrap(err, "failed to get list subscription"))
 
 		return
 	}
+
+	if !req.Envelope {
+		c.JSON(http.StatusOK, response.Items)
+		return
+	}
+
+	setPageLinks(c, &response)
 	c.JSON(http.StatusOK, response)
 }
@@ -0,0 +1,63 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type getAuditLogController struct {
+	useCase usecases.GetAuditLogUseCase
+	logger  logger.Logger
+}
+
+func NewGetAuditLogController(
+	handler *gin.Engine,
+	useCase usecases.GetAuditLogUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &getAuditLogController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.GET("/audit", ct.GetAuditLog, middleware.HandleErrors)
+}
+
+// GetAuditLog godoc
+// @Summary Журнал аудита
+// @Description Возвращает записи журнала аудита всех подписок от старых к новым с фильтрацией по подписке, автору, действию и периоду
+// @Tags audit
+// @Produce json
+// @Param subscription_id query string false "ID подписки"
+// @Param actor query string false "автор изменения (заголовок X-Actor)"
+// @Param action query string false "действие" Enums(create, update, delete, restore, purge, pause, resume, price)
+// @Param from query string false "первый день или месяц периода (YYYY-MM-DD или MM-YYYY)"
+// @Param to query string false "последний день или месяц периода включительно (YYYY-MM-DD или MM-YYYY)"
+// @Param limit query int false "количество записей" default(50)
+// @Param offset query int false "смещение" default(0)
+// @Success 200 {array} responses.AuditEntry
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /audit [get]
+func (ga *getAuditLogController) GetAuditLog(c *gin.Context) {
+	var req requests.GetAuditLog
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := ga.useCase.GetAuditLog(c, req)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to get audit log"))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,57 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type getEndingTrialsController struct {
+	useCase usecases.GetEndingTrialsUseCase
+	logger  logger.Logger
+}
+
+func NewGetEndingTrialsController(
+	handler *gin.Engine,
+	useCase usecases.GetEndingTrialsUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &getEndingTrialsController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.GET("/subscriptions/trials/ending", ct.GetEndingTrials, middleware.HandleErrors)
+}
+
+// GetEndingTrials godoc
+// @Summary Подписки с заканчивающимся пробным периодом
+// @Description Возвращает подписки, пробный период которых заканчивается в ближайшие within (от сегодняшнего дня включительно) и после которого начнутся списания
+// @Tags subscriptions
+// @Produce json
+// @Param within query string false "окно поиска в днях или неделях: 7d, 2w; не больше 366 дней" default(7d)
+// @Success 200 {array} responses.SubResponse
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /subscriptions/trials/ending [get]
+func (gt *getEndingTrialsController) GetEndingTrials(c *gin.Context) {
+	var req requests.GetEndingTrials
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := gt.useCase.GetEndingTrials(c, req)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to get ending trials"))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -36,6 +36,7 @@ func NewGetSubController(
 // @Produce      json
 // @Param 	     sub_id path string true "path format"
 // @Success 	 200 {object} responses.SubResponse
+// @Header 	 200 {string} ETag "версия подписки"
 // @Failure 	 400 {object} string "некорректный формат запроса"
 // @Failure      404 {object} string "подписка не найдена"
 // @Failure      500 {object} string "внутренняя ошибка сервера
@@ -53,5 +54,6 @@ func (gs *getSubController) GetSubscription(c *gin.Context) {
 		return
 	}
 
+	setETag(c, response.Version)
 	c.JSON(http.StatusOK, response)
 }
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type getSubHistoryController struct {
+	useCase usecases.GetSubHistoryUseCase
+	logger  logger.Logger
+}
+
+func NewGetSubHistoryController(
+	handler *gin.Engine,
+	useCase usecases.GetSubHistoryUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &getSubHistoryController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.GET("/subscriptions/:sub_id/history", ct.GetSubscriptionHistory, middleware.HandleErrors)
+}
+
+// GetSubscriptionHistory godoc
+// @Summary История изменений подписки
+// @Description Возвращает записи журнала аудита подписки от старых к новым: кто, когда и в рамках какого запроса изменил подписку, с состоянием до и после изменения. История сохраняется и после очистки подписки
+// @Tags audit
+// @Produce json
+// @Param sub_id path string true "path format"
+// @Param limit query int false "количество записей" default(50)
+// @Param offset query int false "смещение" default(0)
+// @Success 200 {array} responses.AuditEntry
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /subscriptions/{sub_id}/history [get]
+func (gh *getSubHistoryController) GetSubscriptionHistory(c *gin.Context) {
+	subId := c.Param("sub_id")
+	if subId == "" {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	var req requests.GetSubHistory
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := gh.useCase.GetSubscriptionHistory(c, subId, req)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to get subscription history"))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
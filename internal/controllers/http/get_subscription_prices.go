@@ -0,0 +1,57 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type getSubPricesController struct {
+	useCase usecases.GetSubPricesUseCase
+	logger  logger.Logger
+}
+
+func NewGetSubPricesController(
+	handler *gin.Engine,
+	useCase usecases.GetSubPricesUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &getSubPricesController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.GET("/subscriptions/:sub_id/prices", ct.GetSubscriptionPrices, middleware.HandleErrors)
+}
+
+// GetSubscriptionPrices godoc
+// @Summary Получение истории цен подписки
+// @Description Возвращает цену подписки на момент создания и все последующие изменения цены в порядке вступления в силу
+// @Tags subscriptions
+// @Produce json
+// @Param sub_id path string true "path format"
+// @Success 200 {array} responses.SubPrice
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 404 {object} string "подписка не найдена"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /subscriptions/{sub_id}/prices [get]
+func (gp *getSubPricesController) GetSubscriptionPrices(c *gin.Context) {
+	subId := c.Param("sub_id")
+	if subId == "" {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := gp.useCase.GetSubscriptionPrices(c, subId)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to get subscription prices"))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,56 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type getSuggestionsController struct {
+	useCase usecases.GetSuggestionsUseCase
+	logger  logger.Logger
+}
+
+func NewGetSuggestionsController(
+	handler *gin.Engine,
+	useCase usecases.GetSuggestionsUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &getSuggestionsController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.GET("/users/:user_id/suggestions", ct.GetSuggestions, middleware.HandleErrors)
+}
+
+// GetSuggestions godoc
+// @Summary Предложения подписок пользователя
+// @Description Возвращает подписки, найденные в загруженных банковских выписках пользователя, которые еще не приняты и не отклонены
+// @Tags suggestions
+// @Produce json
+// @Param user_id path string true "ID пользователя"
+// @Success 200 {array} responses.Suggestion
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /users/{user_id}/suggestions [get]
+func (gs *getSuggestionsController) GetSuggestions(c *gin.Context) {
+	userID := c.Param("user_id")
+	if userID == "" {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := gs.useCase.GetSuggestions(c, userID)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to get suggestions"))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,58 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type getUserCalendarController struct {
+	useCase usecases.GetUserCalendarUseCase
+	logger  logger.Logger
+}
+
+func NewGetUserCalendarController(
+	handler *gin.Engine,
+	useCase usecases.GetUserCalendarUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &getUserCalendarController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.GET("/users/:user_id/subscriptions.ics", ct.GetUserCalendar, middleware.HandleErrors)
+}
+
+// GetUserCalendar godoc
+// @Summary Календарь продлений подписок пользователя
+// @Description Возвращает календарь iCalendar (RFC 5545) с повторяющимся событием на каждую еще не закончившуюся подписку пользователя.
+// @Description Событие начинается в первый оплачиваемый день (после пробного периода), повторяется с периодом оплаты до end_date и содержит название сервиса и цену ближайшего продления. UID события постоянен для подписки, поэтому календарь можно подключить по ссылке.
+// @Tags subscriptions
+// @Produce text/calendar
+// @Param user_id path string true "ID пользователя"
+// @Success 200 {string} string "календарь iCalendar"
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /users/{user_id}/subscriptions.ics [get]
+func (gu *getUserCalendarController) GetUserCalendar(c *gin.Context) {
+	userID := c.Param("user_id")
+	if userID == "" {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	calendar, err := gu.useCase.GetUserCalendar(c, userID)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to get user calendar"))
+		return
+	}
+
+	c.Header("Content-Disposition", `inline; filename="subscriptions.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", calendar)
+}
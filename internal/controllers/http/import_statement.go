@@ -0,0 +1,68 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type importStatementController struct {
+	useCase usecases.ImportStatementUseCase
+	logger  logger.Logger
+}
+
+func NewImportStatementController(
+	handler *gin.Engine,
+	useCase usecases.ImportStatementUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &importStatementController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.POST("/users/:user_id/statements", ct.ImportStatement, middleware.HandleErrors)
+}
+
+// ImportStatement godoc
+// @Summary Загрузка банковской выписки
+// @Description Разбирает выписку в формате OFX или ISO 20022 CAMT.053 и ищет регулярные списания: не меньше трех списаний одному получателю с интервалом около месяца и отличием суммы не больше 10%, последнее из которых было не раньше чем за 45 дней до конца выписки.
+// @Description Найденные списания, для которых у пользователя нет действующей подписки на тот же сервис, сохраняются как предложения подписок с ценой последнего списания. Повторная загрузка выписки обновляет предложения, а не дублирует их.
+// @Tags suggestions
+// @Accept application/x-ofx
+// @Accept application/xml
+// @Produce json
+// @Param user_id path string true "ID пользователя"
+// @Param format query string false "формат выписки, по умолчанию определяется по содержимому" Enums(ofx, camt053)
+// @Param file body string true "выписка"
+// @Success 200 {object} responses.ImportStatement
+// @Failure 400 {object} string "некорректная выписка или формат"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /users/{user_id}/statements [post]
+func (is *importStatementController) ImportStatement(c *gin.Context) {
+	userID := c.Param("user_id")
+	if userID == "" {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	var req requests.ImportStatement
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := is.useCase.ImportStatement(c, userID, c.Request.Body, req)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to import statement"))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,62 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type importSubsController struct {
+	useCase usecases.ImportSubsUseCase
+	logger  logger.Logger
+}
+
+func NewImportSubsController(
+	handler *gin.Engine,
+	useCase usecases.ImportSubsUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &importSubsController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.POST("/subscriptions/import", ct.ImportSubscriptions, middleware.HandleErrors)
+}
+
+// ImportSubscriptions godoc
+// @Summary Импорт подписок из CSV
+// @Description Загружает подписки из CSV с заголовком. Колонки с именами полей запроса создания подписки (service_name, price, currency, billing_period, user_id, start_date, end_date, trial_start_date, trial_end_date) сопоставляются автоматически, остальные можно сопоставить параметром map, несопоставленные игнорируются.
+// @Description Каждая строка проверяется так же, как при создании подписки. При dry_run=true возвращаются только ошибки строк, иначе корректные строки добавляются в одной транзакции, а строки с ошибками пропускаются.
+// @Tags subscriptions
+// @Accept text/csv
+// @Produce json
+// @Param delimiter query string false "разделитель полей" default(,)
+// @Param map query []string false "сопоставление колонки полю в виде колонка:поле" collectionFormat(multi)
+// @Param dry_run query bool false "только проверить строки" default(false)
+// @Param file body string true "CSV с заголовком"
+// @Success 200 {object} responses.ImportSubscriptions
+// @Failure 400 {object} string "некорректный CSV, разделитель или сопоставление колонок"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /subscriptions/import [post]
+func (is *importSubsController) ImportSubscriptions(c *gin.Context) {
+	var req requests.ImportSubscriptions
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := is.useCase.ImportSubscriptions(c, c.Request.Body, req)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to import subscriptions"))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,108 @@
+package http
+
+import (
+	"encoding/csv"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type loadExchangeRatesController struct {
+	useCase usecases.LoadExchangeRatesUseCase
+	logger  logger.Logger
+}
+
+func NewLoadExchangeRatesController(
+	handler *gin.Engine,
+	useCase usecases.LoadExchangeRatesUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &loadExchangeRatesController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.POST("/admin/exchange-rates", ct.LoadExchangeRates, middleware.HandleErrors)
+}
+
+// LoadExchangeRates godoc
+// @Summary Загрузка курсов валют
+// @Description Загружает курсы валют в формате JSON или CSV (Content-Type: text/csv, заголовок base,quote,rate).
+// @Description Курс пары, загруженной ранее, заменяется новым.
+// @Tags admin
+// @Accept json
+// @Accept text/csv
+// @Produce json
+// @Param rates body []requests.ExchangeRate true "курсы валют"
+// @Success 200 {object} responses.LoadExchangeRates
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /admin/exchange-rates [post]
+func (lr *loadExchangeRatesController) LoadExchangeRates(c *gin.Context) {
+	var req []requests.ExchangeRate
+	var err error
+	if c.ContentType() == "text/csv" {
+		req, err = parseExchangeRatesCSV(c.Request.Body)
+	} else {
+		err = c.ShouldBindJSON(&req)
+	}
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(controllers.ErrDataBindError, err.Error()))
+		return
+	}
+
+	response, err := lr.useCase.LoadExchangeRates(c, req)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to load exchange rates"))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// parseExchangeRatesCSV reads rows of base,quote,rate after a header naming those columns.
+func parseExchangeRatesCSV(body io.Reader) ([]requests.ExchangeRate, error) {
+	reader := csv.NewReader(body)
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read csv header")
+	}
+	if strings.Join(header, ",") != "base,quote,rate" {
+		return nil, errors.New("csv header must be base,quote,rate")
+	}
+
+	var rates []requests.ExchangeRate
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read csv row")
+		}
+
+		rate, err := strconv.ParseFloat(record[2], 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid rate on line %d", len(rates)+2)
+		}
+		rates = append(rates, requests.ExchangeRate{
+			Base:  record[0],
+			Quote: record[1],
+			Rate:  rate,
+		})
+	}
+
+	return rates, nil
+}
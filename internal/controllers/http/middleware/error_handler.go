@@ -12,27 +12,62 @@ func (m *middleware) HandleErrors(c *gin.Context) {
 	if len(c.Errors) > 0 {
 		err := c.Errors.Last()
 
-		if errors.Is(err, controllers.ErrDataBindError) {
-			c.AbortWithStatusJSON(http.StatusBadRequest, err.Error())
+		status := StatusOf(err)
+		if status == http.StatusInternalServerError {
+			m.logger.Err(err).Error().Msgf("Unexpected error: ")
+			c.AbortWithStatusJSON(http.StatusInternalServerError, "Internal server error")
 			return
 		}
 
-		if errors.Is(err, usecases.ErrEntityAlreadyExists) {
-			c.AbortWithStatusJSON(http.StatusConflict, err.Error())
-			return
-		}
+		c.AbortWithStatusJSON(status, err.Error())
+	}
+}
 
-		if errors.Is(err, usecases.ErrEntityNotFound) {
-			c.AbortWithStatusJSON(http.StatusNotFound, err.Error())
-			return
-		}
+// StatusOf returns the HTTP status an error is reported with.
+func StatusOf(err error) int {
+	if errors.Is(err, controllers.ErrDataBindError) || errors.Is(err, controllers.ErrInvalidPaginationParams) {
+		return http.StatusBadRequest
+	}
 
-		if errors.Is(err, usecases.ErrInvalidUUID) || errors.Is(err, usecases.ErrInvalidDateFormat) {
-			c.AbortWithStatusJSON(http.StatusBadRequest, err.Error())
-			return
-		}
+	if errors.Is(err, usecases.ErrEntityAlreadyExists) ||
+		errors.Is(err, usecases.ErrInvalidStatus) ||
+		errors.Is(err, usecases.ErrIdempotencyKeyInUse) {
+		return http.StatusConflict
+	}
 
-		m.logger.Err(err).Error().Msgf("Unexpected error: ")
-		c.AbortWithStatusJSON(http.StatusInternalServerError, "Internal server error")
+	if errors.Is(err, controllers.ErrPreconditionRequired) {
+		return http.StatusPreconditionRequired
 	}
+
+	if errors.Is(err, usecases.ErrVersionMismatch) {
+		return http.StatusPreconditionFailed
+	}
+
+	if errors.Is(err, usecases.ErrIdempotencyKeyReused) || errors.Is(err, usecases.ErrExchangeRateNotFound) {
+		return http.StatusUnprocessableEntity
+	}
+
+	if errors.Is(err, usecases.ErrBatchAborted) {
+		return http.StatusFailedDependency
+	}
+
+	if errors.Is(err, usecases.ErrEntityNotFound) {
+		return http.StatusNotFound
+	}
+
+	if errors.Is(err, usecases.ErrInvalidUUID) ||
+		errors.Is(err, usecases.ErrInvalidDateFormat) ||
+		errors.Is(err, usecases.ErrInvalidPeriod) ||
+		errors.Is(err, usecases.ErrInvalidGroupBy) ||
+		errors.Is(err, usecases.ErrInvalidFilter) ||
+		errors.Is(err, usecases.ErrInvalidCursor) ||
+		errors.Is(err, usecases.ErrInvalidField) ||
+		errors.Is(err, usecases.ErrInvalidCurrency) ||
+		errors.Is(err, usecases.ErrInvalidBillingPeriod) ||
+		errors.Is(err, usecases.ErrInvalidCostMode) ||
+		errors.Is(err, usecases.ErrInvalidProration) {
+		return http.StatusBadRequest
+	}
+
+	return http.StatusInternalServerError
 }
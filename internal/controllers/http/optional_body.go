@@ -0,0 +1,20 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"io"
+	"subscription_service/internal/controllers"
+)
+
+// bindOptionalJSON binds a JSON body that may be omitted altogether, leaving
+// obj with its zero values then.
+func bindOptionalJSON(c *gin.Context, obj any) error {
+	if c.Request.ContentLength == 0 {
+		return nil
+	}
+	if err := c.ShouldBindJSON(obj); err != nil && err != io.EOF {
+		return controllers.ErrDataBindError
+	}
+
+	return nil
+}
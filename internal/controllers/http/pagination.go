@@ -0,0 +1,34 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"strconv"
+	"subscription_service/internal/controllers/responses"
+)
+
+// setPageLinks fills next/prev links of a list envelope. Keyset pages only
+// link forward since a cursor can't be walked backwards.
+func setPageLinks(c *gin.Context, list *responses.SubList) {
+	if list.Cursor != nil {
+		if list.NextCursor != "" {
+			list.Next = pageLink(c, "cursor", list.NextCursor)
+		}
+		return
+	}
+
+	offset := *list.Offset
+	if offset+list.Limit < list.Total {
+		list.Next = pageLink(c, "offset", strconv.Itoa(offset+list.Limit))
+	}
+	if offset > 0 {
+		list.Prev = pageLink(c, "offset", strconv.Itoa(max(0, offset-list.Limit)))
+	}
+}
+
+// pageLink returns the current request path and query with one parameter replaced.
+func pageLink(c *gin.Context, key, value string) string {
+	query := c.Request.URL.Query()
+	query.Set(key, value)
+
+	return c.Request.URL.Path + "?" + query.Encode()
+}
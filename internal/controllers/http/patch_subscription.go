@@ -0,0 +1,80 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type patchSubController struct {
+	useCase usecases.PatchSubUseCase
+	logger  logger.Logger
+}
+
+func NewPatchSubController(
+	handler *gin.Engine,
+	useCase usecases.PatchSubUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &patchSubController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.PATCH("/subscriptions/:sub_id", ct.PatchSubscription, middleware.HandleErrors)
+}
+
+// PatchSubscription godoc
+// @Summary Частичное обновление подписки
+// @Description Частичное обновление подписки по ID в формате JSON Merge Patch (RFC 7396).
+// @Description Отсутствующие поля не изменяются, end_date: null снимает дату окончания.
+// @Description Цена меняется только через POST /subscriptions/{sub_id}/prices.
+// @Tags subscriptions
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param sub_id path string true "path format"
+// @Param If-Match header string true "ETag текущей версии подписки; значение * не принимается"
+// @Param subscription body requests.SubPatchRequest true "изменяемые поля"
+// @Success 	 200 {object} responses.SubResponse
+// @Header 	 200 {string} ETag "новая версия подписки"
+// @Failure 	 400 {object} string "некорректный формат запроса"
+// @Failure      404 {object} string "подписка не найдена"
+// @Failure      412 {object} string "подписка была изменена"
+// @Failure      428 {object} string "не передан заголовок If-Match или передано значение *"
+// @Failure      500 {object} string "внутренняя ошибка сервера"
+// @Router /subscriptions/{sub_id} [patch]
+func (ps *patchSubController) PatchSubscription(c *gin.Context) {
+	subId := c.Param("sub_id")
+	if subId == "" {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	var req requests.SubPatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	response, err := ps.useCase.PatchSubscription(c, subId, version, req)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to patch subscription"))
+		return
+	}
+
+	setETag(c, response.Version)
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type pauseSubController struct {
+	useCase usecases.PauseSubUseCase
+	logger  logger.Logger
+}
+
+func NewPauseSubController(
+	handler *gin.Engine,
+	useCase usecases.PauseSubUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &pauseSubController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.POST("/subscriptions/:sub_id/pause", ct.PauseSubscription, middleware.HandleErrors)
+}
+
+// PauseSubscription godoc
+// @Summary Приостановка подписки
+// @Description Приостанавливает подписку с даты from (по умолчанию сегодня) до возобновления. Дни приостановки не учитываются в подсчете стоимости
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Param sub_id path string true "path format"
+// @Param pause body requests.PauseSubscription false "структура запроса"
+// @Success 200 {object} responses.SubResponse
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 404 {object} string "подписка не найдена"
+// @Failure 409 {object} string "подписка уже приостановлена"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /subscriptions/{sub_id}/pause [post]
+func (ps *pauseSubController) PauseSubscription(c *gin.Context) {
+	subId := c.Param("sub_id")
+	if subId == "" {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	var req requests.PauseSubscription
+	if err := bindOptionalJSON(c, &req); err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	response, err := ps.useCase.PauseSubscription(c, subId, req)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to pause subscription"))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
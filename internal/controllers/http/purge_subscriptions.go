@@ -0,0 +1,47 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type purgeSubsController struct {
+	useCase usecases.PurgeSubsUseCase
+	logger  logger.Logger
+}
+
+func NewPurgeSubsController(
+	handler *gin.Engine,
+	useCase usecases.PurgeSubsUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &purgeSubsController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.POST("/admin/subscriptions/purge", ct.PurgeSubscriptions, middleware.HandleErrors)
+}
+
+// PurgeSubscriptions godoc
+// @Summary Очистка удалённых подписок
+// @Description Окончательно удаляет подписки, удалённые раньше, чем срок хранения из конфигурации (soft_delete.retention)
+// @Tags admin
+// @Produce json
+// @Success 200 {object} responses.PurgeSubscriptions
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /admin/subscriptions/purge [post]
+func (ps *purgeSubsController) PurgeSubscriptions(c *gin.Context) {
+	response, err := ps.useCase.PurgeSubscriptions(c)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to purge subscriptions"))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
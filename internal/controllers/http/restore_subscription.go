@@ -0,0 +1,59 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type restoreSubController struct {
+	useCase usecases.RestoreSubUseCase
+	logger  logger.Logger
+}
+
+func NewRestoreSubController(
+	handler *gin.Engine,
+	useCase usecases.RestoreSubUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &restoreSubController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.POST("/subscriptions/:sub_id/restore", ct.RestoreSubscription, middleware.HandleErrors)
+}
+
+// RestoreSubscription godoc
+// @Summary Восстановление подписки
+// @Description Восстанавливает удалённую подписку, если она ещё не была окончательно удалена очисткой
+// @Tags subscriptions
+// @Produce json
+// @Param sub_id path string true "path format"
+// @Success 200 {object} responses.SubResponse
+// @Header 200 {string} ETag "новая версия подписки"
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 404 {object} string "удалённая подписка не найдена"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /subscriptions/{sub_id}/restore [post]
+func (rs *restoreSubController) RestoreSubscription(c *gin.Context) {
+	subId := c.Param("sub_id")
+	if subId == "" {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	response, err := rs.useCase.RestoreSubscription(c, subId)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to restore subscription"))
+		return
+	}
+
+	setETag(c, response.Version)
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"net/http"
+	"subscription_service/internal/controllers"
+	"subscription_service/internal/controllers/http/middleware"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/usecases"
+	"subscription_service/pkg/logger"
+)
+
+type resumeSubController struct {
+	useCase usecases.ResumeSubUseCase
+	logger  logger.Logger
+}
+
+func NewResumeSubController(
+	handler *gin.Engine,
+	useCase usecases.ResumeSubUseCase,
+	middleware middleware.Middleware,
+	logger logger.Logger,
+) {
+	ct := &resumeSubController{
+		useCase: useCase,
+		logger:  logger,
+	}
+
+	handler.POST("/subscriptions/:sub_id/resume", ct.ResumeSubscription, middleware.HandleErrors)
+}
+
+// ResumeSubscription godoc
+// @Summary Возобновление подписки
+// @Description Завершает текущую приостановку: подписка снова оплачивается с даты on (по умолчанию сегодня)
+// @Tags subscriptions
+// @Accept json
+// @Produce json
+// @Param sub_id path string true "path format"
+// @Param resume body requests.ResumeSubscription false "структура запроса"
+// @Success 200 {object} responses.SubResponse
+// @Failure 400 {object} string "некорректный формат запроса"
+// @Failure 404 {object} string "подписка не найдена"
+// @Failure 409 {object} string "подписка не приостановлена"
+// @Failure 500 {object} string "внутренняя ошибка сервера"
+// @Router /subscriptions/{sub_id}/resume [post]
+func (rs *resumeSubController) ResumeSubscription(c *gin.Context) {
+	subId := c.Param("sub_id")
+	if subId == "" {
+		middleware.AddGinError(c, controllers.ErrDataBindError)
+		return
+	}
+
+	var req requests.ResumeSubscription
+	if err := bindOptionalJSON(c, &req); err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	response, err := rs.useCase.ResumeSubscription(c, subId, req)
+	if err != nil {
+		middleware.AddGinError(c, errors.Wrap(err, "failed to resume subscription"))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -10,19 +10,24 @@ import (
 )
 
 func InitServiceMiddleware(handler *gin.Engine) {
+	// Lets use cases receiving *gin.Context read values put into the request context.
+	handler.ContextWithFallback = true
+
 	handler.Use(gin.Recovery())
 
 	handler.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"*"},
 		AllowMethods:     []string{"PUT", "PATCH", "POST", "GET", "DELETE"},
-		AllowHeaders:     []string{"Origin", "Authorization", "Content-Type", "Accept-Encoding"},
-		ExposeHeaders:    []string{"Content-Length", "Access-Control-Allow-Origin", "Access-Control-Allow-Credentials", "Access-Control-Allow-Headers", "Access-Control-Allow-Methods"},
+		AllowHeaders:     []string{"Origin", "Authorization", "Content-Type", "Accept-Encoding", "If-Match", "Idempotency-Key", "X-Actor", "X-Request-ID"},
+		ExposeHeaders:    []string{"Content-Length", "ETag", "X-Request-ID", "Access-Control-Allow-Origin", "Access-Control-Allow-Credentials", "Access-Control-Allow-Headers", "Access-Control-Allow-Methods"},
 		AllowCredentials: true,
 		AllowOriginFunc: func(origin string) bool {
 			return origin == "*"
 		},
 		MaxAge: 12 * time.Hour,
 	}))
+	handler.Use(dateFormat)
+	handler.Use(auditInfo)
 
 	handler.GET("/", func(c *gin.Context) { c.Redirect(http.StatusPermanentRedirect, "/swagger/index.html") })
 	handler.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
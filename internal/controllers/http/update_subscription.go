@@ -32,15 +32,19 @@ func NewUpdateSubController(
 
 // UpdateSubscription godoc
 // @Summary Обновление подписки
-// @Description Обновление подписки по ID
+// @Description Обновление подписки по ID. price должна совпадать с действующей ценой, новая цена добавляется через POST /subscriptions/{sub_id}/prices
 // @Tags subscriptions
 // @Accept json
 // @Produce json
 // @Param sub_id path string true "path format"
+// @Param If-Match header string true "ETag текущей версии подписки; значение * не принимается"
 // @Param subscription body requests.SubRequest true "структура запроса"
 // @Success 	 200 {object} responses.SubResponse
+// @Header 	 200 {string} ETag "новая версия подписки"
 // @Failure 	 400 {object} string "некорректный формат запроса"
 // @Failure      404 {object} string "подписка не найдена"
+// @Failure      412 {object} string "подписка была изменена"
+// @Failure      428 {object} string "не передан заголовок If-Match или передано значение *"
 // @Failure      500 {object} string "внутренняя ошибка сервера
 // @Router /subscriptions/{sub_id} [put]
 func (us *updateSubController) UpdateSubscription(c *gin.Context) {
@@ -56,11 +60,18 @@ func (us *updateSubController) UpdateSubscription(c *gin.Context) {
 		return
 	}
 
-	response, err := us.useCase.UpdateSubscription(c, subId, req)
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		middleware.AddGinError(c, err)
+		return
+	}
+
+	response, err := us.useCase.UpdateSubscription(c, subId, version, req)
 	if err != nil {
 		middleware.AddGinError(c, errors.Wrap(err, "failed to update subscription"))
 		return
 	}
 
+	setETag(c, response.Version)
 	c.JSON(http.StatusOK, response)
 }
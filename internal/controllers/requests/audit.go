@@ -0,0 +1,16 @@
+package requests
+
+type GetSubHistory struct {
+	Limit  int `form:"limit,default=50" binding:"min=1,max=500"`
+	Offset int `form:"offset,default=0" binding:"min=0"`
+}
+
+type GetAuditLog struct {
+	SubscriptionID string `form:"subscription_id"`
+	Actor          string `form:"actor"`
+	Action         string `form:"action" binding:"omitempty,oneof=create update delete restore purge pause resume price"`
+	From           string `form:"from"`
+	To             string `form:"to"`
+	Limit          int    `form:"limit,default=50" binding:"min=1,max=500"`
+	Offset         int    `form:"offset,default=0" binding:"min=0"`
+}
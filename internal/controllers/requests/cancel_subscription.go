@@ -0,0 +1,6 @@
+package requests
+
+type CancelSubscription struct {
+	Month  string `json:"month,omitempty" example:"08-2025"`
+	Reason string `json:"reason,omitempty" binding:"max=500" example:"слишком дорого"`
+}
@@ -0,0 +1,7 @@
+package requests
+
+type ExchangeRate struct {
+	Base  string  `json:"base" binding:"required,iso4217" example:"USD"`
+	Quote string  `json:"quote" binding:"required,iso4217" example:"RUB"`
+	Rate  float64 `json:"rate" binding:"required,gt=0" example:"92.5"`
+}
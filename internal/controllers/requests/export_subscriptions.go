@@ -0,0 +1,8 @@
+package requests
+
+type ExportSubscriptions struct {
+	SubscriptionFilter
+	Format string `form:"format,default=csv" binding:"oneof=csv jsonl"`
+	Sort   string `form:"sort,default=id" binding:"oneof=id service_name price user_id start_date end_date"`
+	Order  string `form:"order,default=asc" binding:"oneof=asc desc"`
+}
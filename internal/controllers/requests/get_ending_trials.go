@@ -0,0 +1,5 @@
+package requests
+
+type GetEndingTrials struct {
+	Within string `form:"within,default=7d"`
+}
@@ -0,0 +1,22 @@
+package requests
+
+// SubscriptionFilter holds the filters shared by the list and export endpoints.
+type SubscriptionFilter struct {
+	UserID            string `form:"user_id"`
+	ServiceName       string `form:"service_name"`
+	ServiceNamePrefix string `form:"service_name_prefix"`
+	MinPrice          *int   `form:"min_price"`
+	MaxPrice          *int   `form:"max_price"`
+	ActiveAt          string `form:"active_at"`
+	Status            string `form:"status"`
+}
+
+type GetListSubscriptions struct {
+	Limit  int     `form:"limit,default=10"`
+	Offset int     `form:"offset,default=0"`
+	Cursor *string `form:"cursor"`
+	SubscriptionFilter
+	Sort     string `form:"sort,default=id" binding:"oneof=id service_name price user_id start_date end_date"`
+	Order    string `form:"order,default=asc" binding:"oneof=asc desc"`
+	Envelope bool   `form:"envelope,default=false"`
+}
@@ -0,0 +1,10 @@
+package requests
+
+type ImportSubscriptions struct {
+	// Delimiter separates CSV fields, a comma by default.
+	Delimiter string `form:"delimiter"`
+	// Mapping renames CSV columns to SubRequest fields, each as "column:field".
+	// Columns named like a field need no mapping.
+	Mapping []string `form:"map"`
+	DryRun  bool     `form:"dry_run"`
+}
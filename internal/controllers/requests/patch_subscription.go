@@ -0,0 +1,33 @@
+package requests
+
+import "encoding/json"
+
+// Optional tells apart a JSON field that is absent, explicitly null or set,
+// which JSON Merge Patch needs and a plain pointer can't express.
+type Optional[T any] struct {
+	Set   bool
+	Null  bool
+	Value T
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	o.Set = true
+	if string(data) == "null" {
+		o.Null = true
+		return nil
+	}
+
+	return json.Unmarshal(data, &o.Value)
+}
+
+type SubPatchRequest struct {
+	ServiceName   Optional[string] `json:"service_name" swaggertype:"string" example:"Yandex Plus"`
+	Price         Optional[int]    `json:"price" swaggertype:"integer" example:"500"`
+	Currency      Optional[string] `json:"currency" swaggertype:"string" example:"USD"`
+	BillingPeriod Optional[string] `json:"billing_period" swaggertype:"string" example:"yearly"`
+	UserID        Optional[string] `json:"user_id" swaggertype:"string" example:"60601fee-2bf1-4721-ae6f-7636e79a0cba"`
+	StartDate     Optional[string] `json:"start_date" swaggertype:"string" example:"07-2025"`
+	EndDate       Optional[string] `json:"end_date" swaggertype:"string" example:"12-2025"`
+	TrialStart    Optional[string] `json:"trial_start_date" swaggertype:"string" example:"2025-07-01"`
+	TrialEnd      Optional[string] `json:"trial_end_date" swaggertype:"string" example:"2025-07-14"`
+}
@@ -0,0 +1,9 @@
+package requests
+
+type PauseSubscription struct {
+	From string `json:"from,omitempty" example:"2025-06-01"`
+}
+
+type ResumeSubscription struct {
+	On string `json:"on,omitempty" example:"2025-09-01"`
+}
@@ -1,9 +1,13 @@
 package requests
 
 type SubRequest struct {
-	ServiceName string `json:"service_name" binding:"required" example:"Yandex Plus"`
-	Price       int    `json:"price" binding:"required" example:"400"`
-	UserID      string `json:"user_id" binding:"required" example:"60601fee-2bf1-4721-ae6f-7636e79a0cba"`
-	StartDate   string `json:"start_date" binding:"required" example:"07-2025"`
-	EndDate     string `json:"end_date,omitempty" example:"12-2025"`
+	ServiceName   string `json:"service_name" binding:"required" example:"Yandex Plus"`
+	Price         int    `json:"price" binding:"required" example:"400"`
+	Currency      string `json:"currency,omitempty" binding:"omitempty,iso4217" example:"RUB"`
+	BillingPeriod string `json:"billing_period,omitempty" binding:"omitempty,oneof=weekly monthly quarterly yearly" example:"monthly"`
+	UserID        string `json:"user_id" binding:"required" example:"60601fee-2bf1-4721-ae6f-7636e79a0cba"`
+	StartDate     string `json:"start_date" binding:"required" example:"07-2025"`
+	EndDate       string `json:"end_date,omitempty" example:"12-2025"`
+	TrialStart    string `json:"trial_start_date,omitempty" example:"2025-07-01"`
+	TrialEnd      string `json:"trial_end_date,omitempty" example:"2025-07-14"`
 }
@@ -0,0 +1,26 @@
+package requests
+
+// SubBatchRequest creates, updates and deletes subscriptions in one request.
+// Items are validated one by one; with Atomic set either every item is
+// applied or none is.
+type SubBatchRequest struct {
+	Atomic bool             `json:"atomic"`
+	Create []SubRequest     `json:"create,omitempty" binding:"max=5000"`
+	Update []SubBatchUpdate `json:"update,omitempty" binding:"max=5000"`
+	Delete []SubBatchDelete `json:"delete,omitempty" binding:"max=5000"`
+}
+
+// SubBatchUpdate replaces a subscription like PUT /subscriptions/{sub_id}
+// with Version in place of If-Match.
+type SubBatchUpdate struct {
+	ID      string `json:"id" example:"6060ffee-2bf1-4721-ae6f-7636e979a0cb"`
+	Version int    `json:"version" example:"1"`
+	SubRequest
+}
+
+// SubBatchDelete deletes a subscription like DELETE /subscriptions/{sub_id}
+// with Version in place of If-Match.
+type SubBatchDelete struct {
+	ID      string `json:"id" example:"6060ffee-2bf1-4721-ae6f-7636e979a0cb"`
+	Version int    `json:"version" example:"1"`
+}
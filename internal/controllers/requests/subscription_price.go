@@ -0,0 +1,6 @@
+package requests
+
+type SubPriceRequest struct {
+	Price         int    `json:"price" binding:"required,gt=0" example:"500"`
+	EffectiveFrom string `json:"effective_from" binding:"required" example:"01-2026"`
+}
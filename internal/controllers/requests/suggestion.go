@@ -0,0 +1,16 @@
+package requests
+
+type ImportStatement struct {
+	// Format is detected from the file content when omitted.
+	Format string `form:"format" binding:"omitempty,oneof=ofx camt053"`
+}
+
+// AcceptSuggestion overrides the fields of the suggested subscription; the
+// omitted ones keep the suggested values.
+type AcceptSuggestion struct {
+	ServiceName   string `json:"service_name,omitempty" example:"Netflix"`
+	Price         int    `json:"price,omitempty" binding:"omitempty,gt=0" example:"999"`
+	BillingPeriod string `json:"billing_period,omitempty" binding:"omitempty,oneof=weekly monthly quarterly yearly" example:"monthly"`
+	StartDate     string `json:"start_date,omitempty" example:"2025-03-05"`
+	EndDate       string `json:"end_date,omitempty" example:"12-2025"`
+}
@@ -0,0 +1,16 @@
+package responses
+
+import "encoding/json"
+
+// AuditEntry is one recorded change. Before and After hold the subscription
+// row as stored and are null for a created or purged subscription respectively.
+type AuditEntry struct {
+	ID             int64           `json:"id"`
+	SubscriptionID string          `json:"subscription_id"`
+	Action         string          `json:"action" enums:"create,update,delete,restore,purge"`
+	Actor          string          `json:"actor"`
+	RequestID      string          `json:"request_id,omitempty"`
+	Before         json.RawMessage `json:"before" swaggertype:"object"`
+	After          json.RawMessage `json:"after" swaggertype:"object"`
+	CreatedAt      string          `json:"created_at"`
+}
@@ -1,5 +1,20 @@
 package responses
 
 type CalculateTotalCost struct {
-	Total int `json:"total" binding:"required"`
+	Total           int             `json:"total" binding:"required"`
+	UnproratedTotal int             `json:"unprorated_total" binding:"required"`
+	Months          int             `json:"months" binding:"required"`
+	Currency        string          `json:"currency" binding:"required" example:"RUB"`
+	Mode            string          `json:"mode" binding:"required" example:"charge"`
+	Proration       string          `json:"proration" binding:"required" example:"none"`
+	Breakdown       []CostBreakdown `json:"breakdown,omitempty"`
+}
+
+type CostBreakdown struct {
+	ServiceName     string `json:"service_name,omitempty" example:"Yandex Plus"`
+	UserID          string `json:"user_id,omitempty" example:"60601fee-2bf1-4721-ae6f-7636e79a0cba"`
+	Month           string `json:"month,omitempty" example:"07-2025"`
+	Total           int    `json:"total" binding:"required"`
+	UnproratedTotal int    `json:"unprorated_total" binding:"required"`
+	Months          int    `json:"months" binding:"required"`
 }
@@ -0,0 +1,5 @@
+package responses
+
+type LoadExchangeRates struct {
+	Loaded int `json:"loaded" binding:"required"`
+}
@@ -0,0 +1,16 @@
+package responses
+
+// ImportSubscriptions reports a CSV import. Imported is zero for a dry run.
+type ImportSubscriptions struct {
+	DryRun   bool             `json:"dry_run"`
+	Total    int              `json:"total"`
+	Valid    int              `json:"valid"`
+	Imported int              `json:"imported"`
+	Errors   []ImportRowError `json:"errors"`
+}
+
+// ImportRowError explains why the row starting on Line of the file was skipped.
+type ImportRowError struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
@@ -1,10 +1,33 @@
 package responses
 
 type SubResponse struct {
-	ID          string `json:"id" binding:"required"`
-	ServiceName string `json:"service_name" binding:"required"`
-	Price       int    `json:"price" binding:"required"`
-	UserID      string `json:"user_id" binding:"required"`
-	StartDate   string `json:"start_date" binding:"required"`
-	EndDate     string `json:"end_date,omitempty"`
+	ID            string `json:"id" binding:"required"`
+	ServiceName   string `json:"service_name" binding:"required"`
+	Price         int    `json:"price" binding:"required"`
+	Currency      string `json:"currency" binding:"required" example:"RUB"`
+	BillingPeriod string `json:"billing_period" binding:"required" example:"monthly"`
+	UserID        string `json:"user_id" binding:"required"`
+	StartDate     string `json:"start_date" binding:"required"`
+	EndDate       string `json:"end_date,omitempty"`
+	TrialStart    string `json:"trial_start_date,omitempty"`
+	TrialEnd      string `json:"trial_end_date,omitempty"`
+	Status        string `json:"status" binding:"required" enums:"scheduled,trial,active,paused,cancelled,expired" example:"active"`
+	CancelledAt   string `json:"cancelled_at,omitempty" example:"2025-07-15T10:00:00Z"`
+	CancelReason  string `json:"cancel_reason,omitempty" example:"слишком дорого"`
+	Version       int    `json:"-"`
+}
+
+type SubList struct {
+	Items      []SubResponse `json:"items" binding:"required"`
+	Total      int           `json:"total" binding:"required"`
+	Limit      int           `json:"limit" binding:"required"`
+	Offset     *int          `json:"offset,omitempty"`
+	Cursor     *string       `json:"cursor,omitempty"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+	Next       string        `json:"next,omitempty" example:"/subscriptions?limit=10&offset=10"`
+	Prev       string        `json:"prev,omitempty" example:"/subscriptions?limit=10&offset=0"`
+}
+
+type PurgeSubscriptions struct {
+	Purged int `json:"purged" binding:"required"`
 }
@@ -0,0 +1,29 @@
+package responses
+
+const (
+	BatchOperationCreate = "create"
+	BatchOperationUpdate = "update"
+	BatchOperationDelete = "delete"
+)
+
+// SubBatch lists the outcome of every batch item, creates first, then
+// updates and deletes, each in request order.
+type SubBatch struct {
+	Results []SubBatchItem `json:"results"`
+}
+
+// SubBatchItem is the outcome of one batch item. Index is its position in
+// the create, update or delete list of the request. Status is the HTTP
+// status the item would get as a separate request; in an atomic batch that
+// failed, items that were fine get 424.
+type SubBatchItem struct {
+	Operation    string       `json:"operation" enums:"create,update,delete"`
+	Index        int          `json:"index"`
+	ID           string       `json:"id,omitempty"`
+	Status       int          `json:"status"`
+	Subscription *SubResponse `json:"subscription,omitempty"`
+	Error        string       `json:"error,omitempty"`
+
+	// Err is the failure of the item, turned into Status and Error when the response is sent.
+	Err error `json:"-"`
+}
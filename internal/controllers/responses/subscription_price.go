@@ -0,0 +1,6 @@
+package responses
+
+type SubPrice struct {
+	Price         int    `json:"price" binding:"required" example:"500"`
+	EffectiveFrom string `json:"effective_from" binding:"required" example:"01-2026"`
+}
@@ -0,0 +1,20 @@
+package responses
+
+type ImportStatement struct {
+	Format       string `json:"format" binding:"required" enums:"ofx,camt053" example:"ofx"`
+	Transactions int    `json:"transactions" binding:"required"`
+	Debits       int    `json:"debits" binding:"required"`
+	// Suggested counts the recurring debits found that the user doesn't have a subscription for.
+	Suggested int `json:"suggested" binding:"required"`
+}
+
+type Suggestion struct {
+	ID              string `json:"id" binding:"required"`
+	ServiceName     string `json:"service_name" binding:"required" example:"NETFLIX.COM"`
+	Price           int    `json:"price" binding:"required" example:"999"`
+	Currency        string `json:"currency" binding:"required" example:"RUB"`
+	BillingPeriod   string `json:"billing_period" binding:"required" example:"monthly"`
+	FirstChargeDate string `json:"first_charge_date" binding:"required" example:"2025-03-05"`
+	LastChargeDate  string `json:"last_charge_date" binding:"required" example:"2025-06-05"`
+	Charges         int    `json:"charges" binding:"required" example:"4"`
+}
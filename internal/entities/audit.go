@@ -0,0 +1,53 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	AuditActionCreate  = "create"
+	AuditActionUpdate  = "update"
+	AuditActionDelete  = "delete"
+	AuditActionRestore = "restore"
+	AuditActionPurge   = "purge"
+	AuditActionPause   = "pause"
+	AuditActionResume  = "resume"
+	AuditActionPrice   = "price"
+)
+
+var AuditActions = []string{
+	AuditActionCreate,
+	AuditActionUpdate,
+	AuditActionDelete,
+	AuditActionRestore,
+	AuditActionPurge,
+	AuditActionPause,
+	AuditActionResume,
+	AuditActionPrice,
+}
+
+// AuditEntry records one change of a subscription. Before and After hold the
+// row as JSON and are nil when it didn't exist on that side of the change.
+type AuditEntry struct {
+	ID             int64
+	SubscriptionID uuid.UUID
+	Action         string
+	Actor          string
+	RequestID      string
+	Before         []byte
+	After          []byte
+	CreatedAt      time.Time
+}
+
+// AuditFilter narrows down an audit log selection. Nil fields are not
+// applied; To is exclusive.
+type AuditFilter struct {
+	SubscriptionID *uuid.UUID
+	Actor          *string
+	Action         *string
+	From           *time.Time
+	To             *time.Time
+	Limit          int
+	Offset         int
+}
@@ -0,0 +1,18 @@
+package entities
+
+import "time"
+
+// BankTransaction is one entry of a bank statement. Amount is in minor
+// currency units and negative for debits.
+type BankTransaction struct {
+	ID           string
+	BookedAt     time.Time
+	Amount       int64
+	Currency     string
+	Counterparty string
+}
+
+// IsDebit tells whether money left the account.
+func (t BankTransaction) IsDebit() bool {
+	return t.Amount < 0
+}
@@ -0,0 +1,11 @@
+package entities
+
+// ExchangeRate is the number of Quote currency units one unit of Base is worth.
+type ExchangeRate struct {
+	Base  string
+	Quote string
+	Rate  float64
+}
+
+// DefaultCurrency is assumed for subscriptions and totals that don't specify one.
+const DefaultCurrency = "RUB"
@@ -0,0 +1,12 @@
+package entities
+
+import "time"
+
+// IdempotencyRecord is the stored outcome of a request sent with an
+// Idempotency-Key header, replayed when the same key arrives again.
+type IdempotencyRecord struct {
+	Key         string
+	RequestHash string
+	Response    []byte
+	ExpiresAt   time.Time
+}
@@ -5,11 +5,68 @@ import (
 	"time"
 )
 
+const (
+	BillingWeekly    = "weekly"
+	BillingMonthly   = "monthly"
+	BillingQuarterly = "quarterly"
+	BillingYearly    = "yearly"
+)
+
+// Subscription is priced per BillingPeriod in Currency. Days between
+// TrialStartDate and TrialEndDate, both inclusive, are free of charge.
 type Subscription struct {
-	ID          uuid.UUID  `json:"id"`
-	ServiceName string     `json:"service_name"`
-	Price       int        `json:"price"`
-	UserID      uuid.UUID  `json:"user_id"`
-	StartDate   time.Time  `json:"start_date"`
-	EndDate     *time.Time `json:"end_date,omitempty"`
+	ID             uuid.UUID  `json:"id"`
+	ServiceName    string     `json:"service_name"`
+	Price          int        `json:"price"`
+	Currency       string     `json:"currency"`
+	BillingPeriod  string     `json:"billing_period"`
+	UserID         uuid.UUID  `json:"user_id"`
+	StartDate      time.Time  `json:"start_date"`
+	EndDate        *time.Time `json:"end_date,omitempty"`
+	TrialStartDate *time.Time `json:"trial_start_date,omitempty"`
+	TrialEndDate   *time.Time `json:"trial_end_date,omitempty"`
+	CancelledAt    *time.Time `json:"cancelled_at,omitempty"`
+	CancelReason   string     `json:"cancel_reason,omitempty"`
+	Version        int        `json:"version"`
+
+	// CurrentPrice is the price effective today with the price history
+	// applied, while Price stays the initial one. It is filled by reads only.
+	CurrentPrice int `json:"-"`
+	// LastPause is the latest pause, if any, and tells the current status.
+	LastPause *SubscriptionPause `json:"-"`
+	// Pauses lists all pauses ordered by start; it is loaded only to calculate costs.
+	Pauses []SubscriptionPause `json:"-"`
+}
+
+// SubscriptionChanges lists the fields a partial update touches. Nil fields
+// are left untouched, ClearEndDate resets end_date to NULL and ClearTrial
+// removes the trial.
+type SubscriptionChanges struct {
+	ServiceName    *string
+	Currency       *string
+	BillingPeriod  *string
+	UserID         *uuid.UUID
+	StartDate      *time.Time
+	EndDate        *time.Time
+	ClearEndDate   bool
+	TrialStartDate *time.Time
+	TrialEndDate   *time.Time
+	ClearTrial     bool
+	CancelledAt    *time.Time
+	CancelReason   *string
+}
+
+func (c SubscriptionChanges) IsEmpty() bool {
+	return c.ServiceName == nil &&
+		c.Currency == nil &&
+		c.BillingPeriod == nil &&
+		c.UserID == nil &&
+		c.StartDate == nil &&
+		c.EndDate == nil &&
+		!c.ClearEndDate &&
+		c.TrialStartDate == nil &&
+		c.TrialEndDate == nil &&
+		!c.ClearTrial &&
+		c.CancelledAt == nil &&
+		c.CancelReason == nil
 }
@@ -0,0 +1,40 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// SubscriptionFilter narrows down a subscription selection. Nil fields are not applied.
+type SubscriptionFilter struct {
+	UserID            *uuid.UUID
+	ServiceName       *string
+	ServiceNamePrefix *string
+	MinPrice          *int
+	MaxPrice          *int
+	ActiveFrom        *time.Time
+	ActiveTo          *time.Time
+	// Statuses keeps subscriptions whose status on StatusDate is one of the listed.
+	Statuses   []string
+	StatusDate time.Time
+}
+
+type SubscriptionSort struct {
+	Field string
+	Desc  bool
+}
+
+// Page selects a window of an ordered subscription list either by offset
+// or, when After is set, by keyset starting right after the given position.
+type Page struct {
+	Limit  int
+	Offset int
+	After  *Cursor
+}
+
+// Cursor is a position in an ordered subscription list: the value of the
+// sort field and the id of the last row returned.
+type Cursor struct {
+	Value string
+	ID    uuid.UUID
+}
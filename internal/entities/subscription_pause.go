@@ -0,0 +1,20 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// SubscriptionPause is an interval the subscription is not billed in: from
+// PausedFrom up to the day before ResumedAt. A nil ResumedAt means the
+// subscription is still paused.
+type SubscriptionPause struct {
+	SubscriptionID uuid.UUID
+	PausedFrom     time.Time
+	ResumedAt      *time.Time
+}
+
+// Covers tells whether the subscription is paused on the given day.
+func (p SubscriptionPause) Covers(day time.Time) bool {
+	return !day.Before(p.PausedFrom) && (p.ResumedAt == nil || day.Before(*p.ResumedAt))
+}
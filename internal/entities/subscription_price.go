@@ -0,0 +1,14 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// SubscriptionPrice is a price change that applies to the subscription from
+// EffectiveFrom onwards. Months before the first change use Subscription.Price.
+type SubscriptionPrice struct {
+	SubscriptionID uuid.UUID
+	Price          int
+	EffectiveFrom  time.Time
+}
@@ -0,0 +1,50 @@
+package entities
+
+import "time"
+
+const (
+	// StatusScheduled means the subscription hasn't started yet.
+	StatusScheduled = "scheduled"
+	// StatusTrial means the subscription is in its free trial.
+	StatusTrial = "trial"
+	// StatusActive means the subscription is billed.
+	StatusActive = "active"
+	// StatusPaused means billing is paused until the subscription is resumed.
+	StatusPaused = "paused"
+	// StatusCancelled means the subscription was cancelled and ends, or has
+	// ended, on its end date.
+	StatusCancelled = "cancelled"
+	// StatusExpired means the subscription ran until its end date without being cancelled.
+	StatusExpired = "expired"
+)
+
+var SubscriptionStatuses = []string{
+	StatusScheduled,
+	StatusTrial,
+	StatusActive,
+	StatusPaused,
+	StatusCancelled,
+	StatusExpired,
+}
+
+// Status tells what state the subscription is in on the given day. The
+// subscription repository mirrors these rules in SQL to filter by status.
+func (s Subscription) Status(day time.Time) string {
+	switch {
+	case day.Before(s.StartDate):
+		return StatusScheduled
+	case s.EndDate != nil && day.After(*s.EndDate):
+		if s.CancelledAt != nil {
+			return StatusCancelled
+		}
+		return StatusExpired
+	case s.LastPause != nil && s.LastPause.Covers(day):
+		return StatusPaused
+	case s.TrialStartDate != nil && s.TrialEndDate != nil && !day.Before(*s.TrialStartDate) && !day.After(*s.TrialEndDate):
+		return StatusTrial
+	case s.CancelledAt != nil && s.EndDate != nil:
+		return StatusCancelled
+	default:
+		return StatusActive
+	}
+}
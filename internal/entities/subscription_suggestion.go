@@ -0,0 +1,44 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	SuggestionPending   = "pending"
+	SuggestionAccepted  = "accepted"
+	SuggestionDismissed = "dismissed"
+)
+
+// SubscriptionSuggestion is a recurring debit found in a bank statement that
+// looks like a subscription the user doesn't track yet. Merchant is the
+// normalized counterparty name debits are matched by.
+type SubscriptionSuggestion struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	Merchant        string
+	ServiceName     string
+	Price           int
+	Currency        string
+	BillingPeriod   string
+	FirstChargeDate time.Time
+	LastChargeDate  time.Time
+	Charges         int
+	Status          string
+	SubscriptionID  *uuid.UUID
+	CreatedAt       time.Time
+}
+
+// Subscription is the candidate the suggestion proposes: billed since the
+// first charge found and still running.
+func (s SubscriptionSuggestion) Subscription() Subscription {
+	return Subscription{
+		ServiceName:   s.ServiceName,
+		Price:         s.Price,
+		Currency:      s.Currency,
+		BillingPeriod: s.BillingPeriod,
+		UserID:        s.UserID,
+		StartDate:     s.FirstChargeDate,
+	}
+}
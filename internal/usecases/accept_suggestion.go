@@ -0,0 +1,120 @@
+package usecases
+
+import (
+	"cmp"
+	"context"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type acceptSuggestionUseCase struct {
+	suggestionRepo SuggestionStatusRepository
+	subRepo        CreateSubRepository
+	txManager      TxManager
+	logger         logger.Logger
+}
+
+type AcceptSuggestionUseCase interface {
+	AcceptSuggestion(ctx context.Context, userID, suggestionID string, req requests.AcceptSuggestion) (responses.SubResponse, error)
+}
+
+func NewAcceptSuggestionUseCase(
+	suggestionRepo SuggestionStatusRepository,
+	subRepo CreateSubRepository,
+	txManager TxManager,
+	logger logger.Logger,
+) AcceptSuggestionUseCase {
+	return &acceptSuggestionUseCase{
+		suggestionRepo: suggestionRepo,
+		subRepo:        subRepo,
+		txManager:      txManager,
+		logger:         logger,
+	}
+}
+
+// AcceptSuggestion creates the suggested subscription, with the fields given
+// in req replacing the suggested ones, and marks the suggestion accepted in
+// the same transaction. A suggestion can be accepted only once.
+func (a *acceptSuggestionUseCase) AcceptSuggestion(ctx context.Context, userID, suggestionID string, req requests.AcceptSuggestion) (responses.SubResponse, error) {
+	uid, sid, err := parseSuggestionIDs(userID, suggestionID, a.logger)
+	if err != nil {
+		return responses.SubResponse{}, err
+	}
+
+	var sub entities.Subscription
+	err = a.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		suggestion, err := pendingSuggestion(ctx, a.suggestionRepo, uid, sid, a.logger)
+		if err != nil {
+			return err
+		}
+
+		candidate := suggestion.Subscription()
+		sub, err = newSubscription(requests.SubRequest{
+			ServiceName:   cmp.Or(req.ServiceName, candidate.ServiceName),
+			Price:         cmp.Or(req.Price, candidate.Price),
+			Currency:      candidate.Currency,
+			BillingPeriod: cmp.Or(req.BillingPeriod, candidate.BillingPeriod),
+			UserID:        candidate.UserID.String(),
+			StartDate:     cmp.Or(req.StartDate, candidate.StartDate.Format(dayLayout)),
+			EndDate:       req.EndDate,
+		}, a.logger)
+		if err != nil {
+			return err
+		}
+
+		if err := a.subRepo.Insert(ctx, &sub); err != nil {
+			a.logger.Error().Err(err).Msg("Failed to insert subscription")
+			return errors.Wrap(err, "failed to create subscription")
+		}
+
+		if err := a.suggestionRepo.SetStatus(ctx, sid, entities.SuggestionAccepted, &sub.ID); err != nil {
+			a.logger.Error().Err(err).Msg("Failed to accept subscription suggestion")
+			return errors.Wrap(err, "failed to accept subscription suggestion")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return responses.SubResponse{}, err
+	}
+
+	return toSubResponse(ctx, sub), nil
+}
+
+func parseSuggestionIDs(userID, suggestionID string, log logger.Logger) (uuid.UUID, uuid.UUID, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid user_id format")
+		return uuid.Nil, uuid.Nil, errors.Wrap(ErrInvalidUUID, "failed to parse user_id")
+	}
+
+	sid, err := uuid.Parse(suggestionID)
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid suggestion_id format")
+		return uuid.Nil, uuid.Nil, errors.Wrap(ErrInvalidUUID, "failed to parse suggestion_id")
+	}
+
+	return uid, sid, nil
+}
+
+// pendingSuggestion loads a suggestion of the user that is still waiting for
+// a decision.
+func pendingSuggestion(ctx context.Context, repo SuggestionStatusRepository, userID, suggestionID uuid.UUID, log logger.Logger) (entities.SubscriptionSuggestion, error) {
+	suggestion, err := repo.SelectByID(ctx, userID, suggestionID)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get subscription suggestion")
+		return entities.SubscriptionSuggestion{}, errors.Wrap(err, "failed to get subscription suggestion")
+	}
+
+	if suggestion.Status != entities.SuggestionPending {
+		log.Error().Msgf("Subscription suggestion is already %s", suggestion.Status)
+		return entities.SubscriptionSuggestion{}, errors.Wrapf(ErrInvalidStatus, "suggestion is already %s", suggestion.Status)
+	}
+
+	return suggestion, nil
+}
@@ -0,0 +1,165 @@
+package usecases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockSuggestionStatusRepo *MockSuggestionStatusRepository
+	mockAcceptSubRepo        *MockCreateSubRepository
+)
+
+func initAcceptSuggestionTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSuggestionStatusRepo = NewMockSuggestionStatusRepository(ctrl)
+	mockAcceptSubRepo = NewMockCreateSubRepository(ctrl)
+	mockTxManager = NewMockTxManager(ctrl)
+	mockTxManager.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(runWithinTx).AnyTimes()
+	mockLogger = logger.NewMockLogger(t)
+}
+
+func testSuggestion(userID uuid.UUID) entities.SubscriptionSuggestion {
+	firstCharge, _ := time.Parse("2006-01-02", "2025-03-05")
+	lastCharge, _ := time.Parse("2006-01-02", "2025-05-06")
+
+	return entities.SubscriptionSuggestion{
+		ID:              uuid.New(),
+		UserID:          userID,
+		Merchant:        "NETFLIX COM",
+		ServiceName:     "NETFLIX.COM",
+		Price:           649,
+		Currency:        entities.DefaultCurrency,
+		BillingPeriod:   entities.BillingMonthly,
+		FirstChargeDate: firstCharge,
+		LastChargeDate:  lastCharge,
+		Charges:         3,
+		Status:          entities.SuggestionPending,
+	}
+}
+
+func TestAcceptSuggestion_Success(t *testing.T) {
+	initAcceptSuggestionTestMocks(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	suggestion := testSuggestion(userID)
+
+	var created uuid.UUID
+	mockSuggestionStatusRepo.EXPECT().SelectByID(ctx, userID, suggestion.ID).Return(suggestion, nil)
+	mockAcceptSubRepo.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, sub *entities.Subscription) error {
+			assert.Equal(t, "NETFLIX.COM", sub.ServiceName)
+			assert.Equal(t, 649, sub.Price)
+			assert.Equal(t, userID, sub.UserID)
+			assert.Equal(t, "2025-03-05", sub.StartDate.Format("2006-01-02"))
+			assert.Nil(t, sub.EndDate)
+			created = sub.ID
+			return nil
+		})
+	mockSuggestionStatusRepo.EXPECT().SetStatus(ctx, suggestion.ID, entities.SuggestionAccepted, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ uuid.UUID, _ string, subID *uuid.UUID) error {
+			assert.Equal(t, created, *subID)
+			return nil
+		})
+
+	useCase := NewAcceptSuggestionUseCase(mockSuggestionStatusRepo, mockAcceptSubRepo, mockTxManager, mockLogger)
+	response, err := useCase.AcceptSuggestion(ctx, userID.String(), suggestion.ID.String(), requests.AcceptSuggestion{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, created.String(), response.ID)
+	assert.Equal(t, entities.DefaultCurrency, response.Currency)
+	assert.Equal(t, entities.BillingMonthly, response.BillingPeriod)
+}
+
+func TestAcceptSuggestion_Success_Overrides(t *testing.T) {
+	initAcceptSuggestionTestMocks(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	suggestion := testSuggestion(userID)
+	req := requests.AcceptSuggestion{
+		ServiceName: "Netflix",
+		Price:       699,
+		StartDate:   "06-2025",
+		EndDate:     "12-2025",
+	}
+
+	mockSuggestionStatusRepo.EXPECT().SelectByID(ctx, userID, suggestion.ID).Return(suggestion, nil)
+	mockAcceptSubRepo.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, sub *entities.Subscription) error {
+			assert.Equal(t, "Netflix", sub.ServiceName)
+			assert.Equal(t, 699, sub.Price)
+			assert.Equal(t, "2025-06-01", sub.StartDate.Format("2006-01-02"))
+			assert.NotNil(t, sub.EndDate)
+			return nil
+		})
+	mockSuggestionStatusRepo.EXPECT().SetStatus(ctx, suggestion.ID, entities.SuggestionAccepted, gomock.Any()).Return(nil)
+
+	useCase := NewAcceptSuggestionUseCase(mockSuggestionStatusRepo, mockAcceptSubRepo, mockTxManager, mockLogger)
+	response, err := useCase.AcceptSuggestion(ctx, userID.String(), suggestion.ID.String(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Netflix", response.ServiceName)
+	assert.Equal(t, 699, response.Price)
+}
+
+func TestAcceptSuggestion_Failure_AlreadyDismissed(t *testing.T) {
+	initAcceptSuggestionTestMocks(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	suggestion := testSuggestion(userID)
+	suggestion.Status = entities.SuggestionDismissed
+
+	mockSuggestionStatusRepo.EXPECT().SelectByID(ctx, userID, suggestion.ID).Return(suggestion, nil)
+
+	useCase := NewAcceptSuggestionUseCase(mockSuggestionStatusRepo, mockAcceptSubRepo, mockTxManager, mockLogger)
+	_, err := useCase.AcceptSuggestion(ctx, userID.String(), suggestion.ID.String(), requests.AcceptSuggestion{})
+
+	assert.ErrorIs(t, err, ErrInvalidStatus)
+}
+
+func TestAcceptSuggestion_Failure_NotFound(t *testing.T) {
+	initAcceptSuggestionTestMocks(t)
+	ctx := context.Background()
+	userID, suggestionID := uuid.New(), uuid.New()
+
+	mockSuggestionStatusRepo.EXPECT().SelectByID(ctx, userID, suggestionID).Return(entities.SubscriptionSuggestion{}, ErrEntityNotFound)
+
+	useCase := NewAcceptSuggestionUseCase(mockSuggestionStatusRepo, mockAcceptSubRepo, mockTxManager, mockLogger)
+	_, err := useCase.AcceptSuggestion(ctx, userID.String(), suggestionID.String(), requests.AcceptSuggestion{})
+
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestAcceptSuggestion_Failure_InvalidSuggestionID(t *testing.T) {
+	initAcceptSuggestionTestMocks(t)
+
+	useCase := NewAcceptSuggestionUseCase(mockSuggestionStatusRepo, mockAcceptSubRepo, mockTxManager, mockLogger)
+	_, err := useCase.AcceptSuggestion(context.Background(), uuid.New().String(), "invalid-uuid", requests.AcceptSuggestion{})
+
+	assert.ErrorIs(t, err, ErrInvalidUUID)
+}
+
+func TestAcceptSuggestion_Failure_InsertError(t *testing.T) {
+	initAcceptSuggestionTestMocks(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	suggestion := testSuggestion(userID)
+	dbErr := errors.New("database error")
+
+	mockSuggestionStatusRepo.EXPECT().SelectByID(ctx, userID, suggestion.ID).Return(suggestion, nil)
+	mockAcceptSubRepo.EXPECT().Insert(ctx, gomock.Any()).Return(dbErr)
+
+	useCase := NewAcceptSuggestionUseCase(mockSuggestionStatusRepo, mockAcceptSubRepo, mockTxManager, mockLogger)
+	_, err := useCase.AcceptSuggestion(ctx, userID.String(), suggestion.ID.String(), requests.AcceptSuggestion{})
+
+	assert.ErrorIs(t, err, dbErr)
+}
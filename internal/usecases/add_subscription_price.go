@@ -0,0 +1,74 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"time"
+)
+
+type addSubPriceUseCase struct {
+	subRepo AddSubPriceRepository
+	logger  logger.Logger
+}
+
+type AddSubPriceUseCase interface {
+	AddSubscriptionPrice(ctx context.Context, subID string, req requests.SubPriceRequest) (responses.SubPrice, error)
+}
+
+func NewAddSubPriceUseCase(subRepo AddSubPriceRepository, logger logger.Logger) AddSubPriceUseCase {
+	return &addSubPriceUseCase{
+		subRepo: subRepo,
+		logger:  logger,
+	}
+}
+
+// AddSubscriptionPrice records a price change effective from the given month.
+// Past months keep the price they were billed at.
+func (a *addSubPriceUseCase) AddSubscriptionPrice(ctx context.Context, subID string, req requests.SubPriceRequest) (responses.SubPrice, error) {
+	id, err := uuid.Parse(subID)
+	if err != nil {
+		a.logger.Error().Err(err).Msg("Invalid sub_id format")
+		return responses.SubPrice{}, errors.Wrap(ErrInvalidUUID, "failed to parse sub_id")
+	}
+
+	effectiveFrom, err := time.Parse(monthLayout, req.EffectiveFrom)
+	if err != nil {
+		a.logger.Error().Err(err).Msg("Invalid effective_from format")
+		return responses.SubPrice{}, errors.Wrap(ErrInvalidDateFormat, "failed to parse effective_from")
+	}
+
+	sub, err := a.subRepo.SelectByID(ctx, subID)
+	if err != nil {
+		a.logger.Error().Err(err).Msg("Failed to get subscription")
+		return responses.SubPrice{}, errors.Wrap(err, "failed to get subscription")
+	}
+
+	if monthIndex(effectiveFrom) <= monthIndex(sub.StartDate) {
+		a.logger.Error().Msg("effective_from is not after start_date")
+		return responses.SubPrice{}, errors.Wrap(ErrInvalidPeriod, "effective_from must be after start_date")
+	}
+	if sub.EndDate != nil && monthIndex(effectiveFrom) > monthIndex(*sub.EndDate) {
+		a.logger.Error().Msg("effective_from is after end_date")
+		return responses.SubPrice{}, errors.Wrap(ErrInvalidPeriod, "effective_from is after end_date")
+	}
+
+	price := entities.SubscriptionPrice{
+		SubscriptionID: id,
+		Price:          req.Price,
+		EffectiveFrom:  effectiveFrom,
+	}
+	if err := a.subRepo.AddPrice(ctx, price); err != nil {
+		a.logger.Error().Err(err).Msg("Failed to insert subscription price")
+		return responses.SubPrice{}, errors.Wrap(err, "failed to add subscription price")
+	}
+
+	return responses.SubPrice{
+		Price:         price.Price,
+		EffectiveFrom: formatDate(ctx, price.EffectiveFrom),
+	}, nil
+}
@@ -0,0 +1,107 @@
+package usecases
+
+import (
+	"context"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var mockAddPriceSubRepo *MockAddSubPriceRepository
+
+func initAddSubPriceTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAddPriceSubRepo = NewMockAddSubPriceRepository(ctrl)
+	mockLogger = logger.NewMockLogger(t)
+}
+
+func TestAddSubscriptionPrice_Success(t *testing.T) {
+	initAddSubPriceTestMocks(t)
+	ctx := context.Background()
+	startDate, _ := time.Parse("01-2006", "07-2025")
+	effectiveFrom, _ := time.Parse("01-2006", "01-2026")
+	sub := entities.Subscription{ID: uuid.New(), Price: 400, UserID: uuid.New(), StartDate: startDate}
+	req := requests.SubPriceRequest{Price: 500, EffectiveFrom: "01-2026"}
+
+	mockAddPriceSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+	mockAddPriceSubRepo.EXPECT().AddPrice(ctx, entities.SubscriptionPrice{
+		SubscriptionID: sub.ID,
+		Price:          500,
+		EffectiveFrom:  effectiveFrom,
+	}).Return(nil)
+
+	useCase := NewAddSubPriceUseCase(mockAddPriceSubRepo, mockLogger)
+	response, err := useCase.AddSubscriptionPrice(ctx, sub.ID.String(), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 500, response.Price)
+	assert.Equal(t, "01-2026", response.EffectiveFrom)
+}
+
+func TestAddSubscriptionPrice_Failure_InvalidDate(t *testing.T) {
+	initAddSubPriceTestMocks(t)
+	ctx := context.Background()
+	req := requests.SubPriceRequest{Price: 500, EffectiveFrom: "2026-01"}
+
+	useCase := NewAddSubPriceUseCase(mockAddPriceSubRepo, mockLogger)
+	_, err := useCase.AddSubscriptionPrice(ctx, uuid.New().String(), req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidDateFormat)
+}
+
+func TestAddSubscriptionPrice_Failure_NotAfterStart(t *testing.T) {
+	initAddSubPriceTestMocks(t)
+	ctx := context.Background()
+	startDate, _ := time.Parse("01-2006", "07-2025")
+	sub := entities.Subscription{ID: uuid.New(), Price: 400, UserID: uuid.New(), StartDate: startDate}
+	req := requests.SubPriceRequest{Price: 500, EffectiveFrom: "07-2025"}
+
+	mockAddPriceSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+
+	useCase := NewAddSubPriceUseCase(mockAddPriceSubRepo, mockLogger)
+	_, err := useCase.AddSubscriptionPrice(ctx, sub.ID.String(), req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
+}
+
+func TestAddSubscriptionPrice_Failure_AfterEnd(t *testing.T) {
+	initAddSubPriceTestMocks(t)
+	ctx := context.Background()
+	startDate, _ := time.Parse("01-2006", "07-2025")
+	endDate, _ := time.Parse("01-2006", "12-2025")
+	sub := entities.Subscription{ID: uuid.New(), Price: 400, UserID: uuid.New(), StartDate: startDate, EndDate: &endDate}
+	req := requests.SubPriceRequest{Price: 500, EffectiveFrom: "01-2026"}
+
+	mockAddPriceSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+
+	useCase := NewAddSubPriceUseCase(mockAddPriceSubRepo, mockLogger)
+	_, err := useCase.AddSubscriptionPrice(ctx, sub.ID.String(), req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
+}
+
+func TestAddSubscriptionPrice_Failure_AlreadyExists(t *testing.T) {
+	initAddSubPriceTestMocks(t)
+	ctx := context.Background()
+	startDate, _ := time.Parse("01-2006", "07-2025")
+	sub := entities.Subscription{ID: uuid.New(), Price: 400, UserID: uuid.New(), StartDate: startDate}
+	req := requests.SubPriceRequest{Price: 500, EffectiveFrom: "01-2026"}
+
+	mockAddPriceSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+	mockAddPriceSubRepo.EXPECT().AddPrice(ctx, gomock.Any()).Return(ErrEntityAlreadyExists)
+
+	useCase := NewAddSubPriceUseCase(mockAddPriceSubRepo, mockLogger)
+	_, err := useCase.AddSubscriptionPrice(ctx, sub.ID.String(), req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrEntityAlreadyExists)
+}
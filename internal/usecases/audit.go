@@ -0,0 +1,29 @@
+package usecases
+
+import "context"
+
+// SystemActor is recorded for changes made outside of an HTTP request.
+const SystemActor = "system"
+
+type auditInfoKey struct{}
+
+type auditInfo struct {
+	actor     string
+	requestID string
+}
+
+// WithAuditInfo returns a context whose changes are recorded in the audit log
+// as made by actor within the given request.
+func WithAuditInfo(ctx context.Context, actor, requestID string) context.Context {
+	return context.WithValue(ctx, auditInfoKey{}, auditInfo{actor: actor, requestID: requestID})
+}
+
+// AuditInfo returns the actor and request id changes in ctx are recorded with.
+func AuditInfo(ctx context.Context) (actor, requestID string) {
+	info, ok := ctx.Value(auditInfoKey{}).(auditInfo)
+	if !ok || info.actor == "" {
+		return SystemActor, info.requestID
+	}
+
+	return info.actor, info.requestID
+}
@@ -0,0 +1,240 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+)
+
+type batchSubUseCase struct {
+	subRepo   BatchSubRepository
+	txManager TxManager
+	logger    logger.Logger
+}
+
+type BatchSubUseCase interface {
+	BatchSubscriptions(ctx context.Context, req requests.SubBatchRequest) (responses.SubBatch, error)
+}
+
+func NewBatchSubUseCase(subRepo BatchSubRepository, txManager TxManager, logger logger.Logger) BatchSubUseCase {
+	return &batchSubUseCase{
+		subRepo:   subRepo,
+		txManager: txManager,
+		logger:    logger,
+	}
+}
+
+// batchOp is a validated batch item waiting to be applied.
+type batchOp struct {
+	result *responses.SubBatchItem
+	sub    entities.Subscription
+}
+
+// BatchSubscriptions validates every item and applies the valid ones. An
+// atomic batch is applied in one transaction and only if every item is
+// valid and succeeds; otherwise each item succeeds or fails on its own.
+// Creates are copied into the table at once.
+func (b *batchSubUseCase) BatchSubscriptions(ctx context.Context, req requests.SubBatchRequest) (responses.SubBatch, error) {
+	total := len(req.Create) + len(req.Update) + len(req.Delete)
+	if total == 0 {
+		b.logger.Error().Msg("Empty batch")
+		return responses.SubBatch{}, errors.Wrap(ErrInvalidField, "batch has no items")
+	}
+
+	batch := responses.SubBatch{Results: make([]responses.SubBatchItem, 0, total)}
+	for i := range req.Create {
+		batch.Results = append(batch.Results, responses.SubBatchItem{Operation: responses.BatchOperationCreate, Index: i})
+	}
+	for i := range req.Update {
+		batch.Results = append(batch.Results, responses.SubBatchItem{Operation: responses.BatchOperationUpdate, Index: i})
+	}
+	for i := range req.Delete {
+		batch.Results = append(batch.Results, responses.SubBatchItem{Operation: responses.BatchOperationDelete, Index: i})
+	}
+
+	creates, updates, deletes := b.validate(req, batch.Results)
+
+	if !req.Atomic {
+		// Each item's error is recorded in its result, so this never fails.
+		_ = b.apply(ctx, creates, updates, deletes, false)
+		return batch, nil
+	}
+
+	if failed(batch.Results) {
+		abort(batch.Results)
+		return batch, nil
+	}
+
+	err := b.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		return b.apply(ctx, creates, updates, deletes, true)
+	})
+	if err != nil {
+		if !failed(batch.Results) {
+			b.logger.Error().Err(err).Msg("Failed to apply batch")
+			return responses.SubBatch{}, errors.Wrap(err, "failed to apply batch")
+		}
+		abort(batch.Results)
+	}
+
+	return batch, nil
+}
+
+// validate parses the items, recording the error of each invalid one in its
+// result, and returns the valid ones.
+func (b *batchSubUseCase) validate(req requests.SubBatchRequest, results []responses.SubBatchItem) (creates, updates, deletes []batchOp) {
+	next := 0
+	for _, item := range req.Create {
+		result := &results[next]
+		next++
+
+		sub, err := newSubscription(item, b.logger)
+		if err != nil {
+			result.Err = err
+			continue
+		}
+		result.ID = sub.ID.String()
+		creates = append(creates, batchOp{result: result, sub: sub})
+	}
+
+	for _, item := range req.Update {
+		result := &results[next]
+		next++
+		result.ID = item.ID
+
+		id, err := parseBatchTarget(item.ID, item.Version)
+		if err != nil {
+			result.Err = err
+			continue
+		}
+		sub, err := newSubscription(item.SubRequest, b.logger)
+		if err != nil {
+			result.Err = err
+			continue
+		}
+		sub.ID = id
+		sub.Version = item.Version
+		updates = append(updates, batchOp{result: result, sub: sub})
+	}
+
+	for _, item := range req.Delete {
+		result := &results[next]
+		next++
+		result.ID = item.ID
+
+		id, err := parseBatchTarget(item.ID, item.Version)
+		if err != nil {
+			result.Err = err
+			continue
+		}
+		deletes = append(deletes, batchOp{result: result, sub: entities.Subscription{ID: id, Version: item.Version}})
+	}
+
+	return creates, updates, deletes
+}
+
+func parseBatchTarget(subID string, version int) (uuid.UUID, error) {
+	id, err := uuid.Parse(subID)
+	if err != nil {
+		return uuid.UUID{}, errors.Wrap(ErrInvalidUUID, "failed to parse id")
+	}
+	if version <= 0 {
+		return uuid.UUID{}, errors.Wrap(ErrInvalidField, "version is required")
+	}
+
+	return id, nil
+}
+
+// apply writes the validated items. When atomic, it stops at the first
+// failure and returns it so that the transaction is rolled back.
+func (b *batchSubUseCase) apply(ctx context.Context, creates, updates, deletes []batchOp, atomic bool) error {
+	if len(creates) > 0 {
+		subs := make([]entities.Subscription, 0, len(creates))
+		for _, op := range creates {
+			subs = append(subs, op.sub)
+		}
+
+		if err := b.subRepo.InsertBatch(ctx, subs); err == nil {
+			for _, op := range creates {
+				op.result.Subscription = subResponse(ctx, op.sub)
+			}
+		} else {
+			// The failed copy rolled back to its savepoint and stored nothing,
+			// so retrying one by one tells which items are at fault.
+			b.logger.Error().Err(err).Msg("Failed to insert subscriptions at once, inserting one by one")
+			for _, op := range creates {
+				if err := b.subRepo.Insert(ctx, &op.sub); err != nil {
+					b.logger.Error().Err(err).Msg("Failed to insert subscription")
+					op.result.Err = errors.Wrap(err, "failed to create subscription")
+					if atomic {
+						return op.result.Err
+					}
+					continue
+				}
+				op.result.Subscription = subResponse(ctx, op.sub)
+			}
+		}
+	}
+
+	for _, op := range updates {
+		if err := keepStoredState(ctx, b.subRepo, &op.sub, b.logger); err != nil {
+			op.result.Err = err
+			if atomic {
+				return op.result.Err
+			}
+			continue
+		}
+		if err := b.subRepo.Update(ctx, &op.sub); err != nil {
+			b.logger.Error().Err(err).Msg("Failed to update subscription")
+			op.result.Err = errors.Wrap(err, "failed to update subscription")
+			if atomic {
+				return op.result.Err
+			}
+			continue
+		}
+		op.result.Subscription = subResponse(ctx, op.sub)
+	}
+
+	for _, op := range deletes {
+		if err := b.subRepo.Delete(ctx, op.sub.ID.String(), op.sub.Version); err != nil {
+			b.logger.Error().Err(err).Msg("Failed to delete subscription")
+			op.result.Err = errors.Wrap(err, "failed to delete subscription")
+			if atomic {
+				return op.result.Err
+			}
+		}
+	}
+
+	return nil
+}
+
+func subResponse(ctx context.Context, sub entities.Subscription) *responses.SubResponse {
+	response := toSubResponse(ctx, sub)
+	return &response
+}
+
+func failed(results []responses.SubBatchItem) bool {
+	for _, result := range results {
+		if result.Err != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// abort marks the items of a rolled back atomic batch that didn't fail themselves.
+func abort(results []responses.SubBatchItem) {
+	for i := range results {
+		if results[i].Err == nil {
+			results[i].Err = ErrBatchAborted
+			results[i].Subscription = nil
+			if results[i].Operation == responses.BatchOperationCreate {
+				results[i].ID = ""
+			}
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package usecases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var mockBatchSubRepo *MockBatchSubRepository
+
+func initBatchSubTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockBatchSubRepo = NewMockBatchSubRepository(ctrl)
+	mockTxManager = NewMockTxManager(ctrl)
+	mockTxManager.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(runWithinTx).AnyTimes()
+	mockLogger = logger.NewMockLogger(t)
+}
+
+func batchSubRequest() requests.SubRequest {
+	return requests.SubRequest{
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		UserID:      uuid.New().String(),
+		StartDate:   "07-2025",
+	}
+}
+
+func TestBatchSubscriptions_Success(t *testing.T) {
+	initBatchSubTestMocks(t)
+	ctx := context.Background()
+	updateID, deleteID := uuid.New(), uuid.New()
+	req := requests.SubBatchRequest{
+		Create: []requests.SubRequest{batchSubRequest(), batchSubRequest()},
+		Update: []requests.SubBatchUpdate{{ID: updateID.String(), Version: 2, SubRequest: batchSubRequest()}},
+		Delete: []requests.SubBatchDelete{{ID: deleteID.String(), Version: 1}},
+	}
+
+	mockBatchSubRepo.EXPECT().InsertBatch(ctx, gomock.Len(2)).Return(nil)
+	mockBatchSubRepo.EXPECT().SelectByID(ctx, updateID.String()).Return(storedSubscription(updateID.String(), 400), nil)
+	mockBatchSubRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, sub *entities.Subscription) error {
+			assert.Equal(t, updateID, sub.ID)
+			assert.Equal(t, 2, sub.Version)
+			sub.Version = 3
+			return nil
+		})
+	mockBatchSubRepo.EXPECT().Delete(ctx, deleteID.String(), 1).Return(nil)
+
+	useCase := NewBatchSubUseCase(mockBatchSubRepo, mockTxManager, mockLogger)
+	response, err := useCase.BatchSubscriptions(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Len(t, response.Results, 4)
+	for _, result := range response.Results {
+		assert.NoError(t, result.Err)
+	}
+	assert.Equal(t, responses.BatchOperationCreate, response.Results[1].Operation)
+	assert.Equal(t, 1, response.Results[1].Index)
+	assert.Equal(t, 1, response.Results[0].Subscription.Version)
+	assert.Equal(t, 3, response.Results[2].Subscription.Version)
+	assert.Equal(t, deleteID.String(), response.Results[3].ID)
+}
+
+func TestBatchSubscriptions_Success_PartialNonAtomic(t *testing.T) {
+	initBatchSubTestMocks(t)
+	ctx := context.Background()
+	invalid := batchSubRequest()
+	invalid.StartDate = "invalid-date"
+	deleteID := uuid.New()
+	req := requests.SubBatchRequest{
+		Create: []requests.SubRequest{batchSubRequest(), invalid},
+		Delete: []requests.SubBatchDelete{{ID: deleteID.String(), Version: 1}},
+	}
+
+	mockBatchSubRepo.EXPECT().InsertBatch(ctx, gomock.Len(1)).Return(nil)
+	mockBatchSubRepo.EXPECT().Delete(ctx, deleteID.String(), 1).Return(ErrVersionMismatch)
+
+	useCase := NewBatchSubUseCase(mockBatchSubRepo, mockTxManager, mockLogger)
+	response, err := useCase.BatchSubscriptions(ctx, req)
+
+	assert.NoError(t, err)
+	assert.NoError(t, response.Results[0].Err)
+	assert.NotNil(t, response.Results[0].Subscription)
+	assert.ErrorIs(t, response.Results[1].Err, ErrInvalidDateFormat)
+	assert.ErrorIs(t, response.Results[2].Err, ErrVersionMismatch)
+}
+
+func TestBatchSubscriptions_Success_InvalidPriceFailsOnlyItsItem(t *testing.T) {
+	initBatchSubTestMocks(t)
+	ctx := context.Background()
+	negative := batchSubRequest()
+	negative.Price = -5
+	req := requests.SubBatchRequest{Create: []requests.SubRequest{batchSubRequest(), negative}}
+
+	mockBatchSubRepo.EXPECT().InsertBatch(ctx, gomock.Len(1)).Return(nil)
+
+	useCase := NewBatchSubUseCase(mockBatchSubRepo, mockTxManager, mockLogger)
+	response, err := useCase.BatchSubscriptions(ctx, req)
+
+	assert.NoError(t, err)
+	assert.NoError(t, response.Results[0].Err)
+	assert.ErrorIs(t, response.Results[1].Err, ErrInvalidField)
+}
+
+func TestBatchSubscriptions_Success_CopyFallsBackToSingleInserts(t *testing.T) {
+	initBatchSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.SubBatchRequest{Create: []requests.SubRequest{batchSubRequest(), batchSubRequest()}}
+
+	mockBatchSubRepo.EXPECT().InsertBatch(ctx, gomock.Any()).Return(errors.New("copy failed"))
+	mockBatchSubRepo.EXPECT().Insert(ctx, gomock.Any()).Return(nil)
+	mockBatchSubRepo.EXPECT().Insert(ctx, gomock.Any()).Return(ErrEntityAlreadyExists)
+
+	useCase := NewBatchSubUseCase(mockBatchSubRepo, mockTxManager, mockLogger)
+	response, err := useCase.BatchSubscriptions(ctx, req)
+
+	assert.NoError(t, err)
+	assert.NoError(t, response.Results[0].Err)
+	assert.ErrorIs(t, response.Results[1].Err, ErrEntityAlreadyExists)
+}
+
+func TestBatchSubscriptions_Failure_AtomicInvalidItem(t *testing.T) {
+	initBatchSubTestMocks(t)
+	ctx := context.Background()
+	invalid := batchSubRequest()
+	invalid.Currency = "usd"
+	req := requests.SubBatchRequest{
+		Atomic: true,
+		Create: []requests.SubRequest{batchSubRequest(), invalid},
+		Delete: []requests.SubBatchDelete{{ID: "invalid-uuid", Version: 1}},
+	}
+
+	useCase := NewBatchSubUseCase(mockBatchSubRepo, mockTxManager, mockLogger)
+	response, err := useCase.BatchSubscriptions(ctx, req)
+
+	// Nothing is written when an item of an atomic batch is invalid.
+	assert.NoError(t, err)
+	assert.ErrorIs(t, response.Results[0].Err, ErrBatchAborted)
+	assert.Empty(t, response.Results[0].ID)
+	assert.ErrorIs(t, response.Results[1].Err, ErrInvalidCurrency)
+	assert.ErrorIs(t, response.Results[2].Err, ErrInvalidUUID)
+}
+
+func TestBatchSubscriptions_Failure_AtomicRolledBack(t *testing.T) {
+	initBatchSubTestMocks(t)
+	ctx := context.Background()
+	updateID := uuid.New()
+	req := requests.SubBatchRequest{
+		Atomic: true,
+		Create: []requests.SubRequest{batchSubRequest()},
+		Update: []requests.SubBatchUpdate{{ID: updateID.String(), Version: 1, SubRequest: batchSubRequest()}},
+	}
+
+	mockBatchSubRepo.EXPECT().InsertBatch(ctx, gomock.Any()).Return(nil)
+	mockBatchSubRepo.EXPECT().SelectByID(ctx, updateID.String()).Return(entities.Subscription{}, ErrEntityNotFound)
+
+	useCase := NewBatchSubUseCase(mockBatchSubRepo, mockTxManager, mockLogger)
+	response, err := useCase.BatchSubscriptions(ctx, req)
+
+	assert.NoError(t, err)
+	assert.ErrorIs(t, response.Results[0].Err, ErrBatchAborted)
+	assert.Nil(t, response.Results[0].Subscription)
+	assert.ErrorIs(t, response.Results[1].Err, ErrEntityNotFound)
+}
+
+func TestBatchSubscriptions_Failure_AtomicCopyError(t *testing.T) {
+	initBatchSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.SubBatchRequest{
+		Atomic: true,
+		Create: []requests.SubRequest{batchSubRequest(), batchSubRequest(), batchSubRequest()},
+	}
+
+	mockBatchSubRepo.EXPECT().InsertBatch(ctx, gomock.Any()).Return(errors.New("copy failed"))
+	mockBatchSubRepo.EXPECT().Insert(ctx, gomock.Any()).Return(nil)
+	mockBatchSubRepo.EXPECT().Insert(ctx, gomock.Any()).Return(ErrEntityAlreadyExists)
+
+	useCase := NewBatchSubUseCase(mockBatchSubRepo, mockTxManager, mockLogger)
+	response, err := useCase.BatchSubscriptions(ctx, req)
+
+	// The item at fault is found one by one and the rest are rolled back.
+	assert.NoError(t, err)
+	assert.ErrorIs(t, response.Results[0].Err, ErrBatchAborted)
+	assert.Nil(t, response.Results[0].Subscription)
+	assert.ErrorIs(t, response.Results[1].Err, ErrEntityAlreadyExists)
+	assert.ErrorIs(t, response.Results[2].Err, ErrBatchAborted)
+}
+
+func TestBatchSubscriptions_Failure_Empty(t *testing.T) {
+	initBatchSubTestMocks(t)
+
+	useCase := NewBatchSubUseCase(mockBatchSubRepo, mockTxManager, mockLogger)
+	_, err := useCase.BatchSubscriptions(context.Background(), requests.SubBatchRequest{Atomic: true})
+
+	assert.ErrorIs(t, err, ErrInvalidField)
+}
+
+func TestBatchSubscriptions_Failure_MissingVersion(t *testing.T) {
+	initBatchSubTestMocks(t)
+	req := requests.SubBatchRequest{Delete: []requests.SubBatchDelete{{ID: uuid.New().String()}}}
+
+	useCase := NewBatchSubUseCase(mockBatchSubRepo, mockTxManager, mockLogger)
+	response, err := useCase.BatchSubscriptions(context.Background(), req)
+
+	assert.NoError(t, err)
+	assert.ErrorIs(t, response.Results[0].Err, ErrInvalidField)
+}
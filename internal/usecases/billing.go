@@ -0,0 +1,119 @@
+package usecases
+
+import (
+	"math"
+	"subscription_service/internal/entities"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// CostModeCharge bills the full price in the months a charge actually happens.
+	CostModeCharge = "charge"
+	// CostModeAmortized spreads the price of a billing period evenly over its months.
+	CostModeAmortized = "amortized"
+)
+
+const weeksPerYear = 52
+
+func parseBillingPeriod(period string) (string, error) {
+	switch period {
+	case "":
+		return entities.BillingMonthly, nil
+	case entities.BillingWeekly, entities.BillingMonthly, entities.BillingQuarterly, entities.BillingYearly:
+		return period, nil
+	default:
+		return "", errors.Wrapf(ErrInvalidBillingPeriod, "unknown billing period %q", period)
+	}
+}
+
+func parseCostMode(mode string) (string, error) {
+	switch mode {
+	case "":
+		return CostModeCharge, nil
+	case CostModeCharge, CostModeAmortized:
+		return mode, nil
+	default:
+		return "", errors.Wrapf(ErrInvalidCostMode, "unknown mode %q", mode)
+	}
+}
+
+// monthlyCost returns what the subscription costs in the given active month
+// when price is charged once per billing period.
+func monthlyCost(sub entities.Subscription, month int, price int, mode string) int {
+	switch sub.BillingPeriod {
+	case entities.BillingWeekly:
+		if mode == CostModeAmortized {
+			return int(math.Round(float64(price) * weeksPerYear / 12))
+		}
+		return price * weeklyCharges(sub, month)
+	case entities.BillingQuarterly:
+		return periodCost(sub, month, price, mode, 3)
+	case entities.BillingYearly:
+		return periodCost(sub, month, price, mode, 12)
+	default:
+		return price
+	}
+}
+
+// periodCost handles plans billed every `months` months, charged on the
+// anniversary of the month billing starts in.
+func periodCost(sub entities.Subscription, month int, price int, mode string, months int) int {
+	if mode == CostModeAmortized {
+		return int(math.Round(float64(price) / float64(months)))
+	}
+	if (month-monthIndex(billingStart(sub)))%months == 0 {
+		return price
+	}
+
+	return 0
+}
+
+// weeklyCharges counts the weekly charges, starting when billing starts and
+// stopping after the end date, that fall into the given calendar month.
+// Charges falling on trial or paused days are skipped.
+func weeklyCharges(sub entities.Subscription, month int) int {
+	start := billingStart(sub)
+	first := monthStart(month)
+	last := monthStart(month+1).AddDate(0, 0, -1)
+	if start.After(first) {
+		first = start
+	}
+	if sub.EndDate != nil && sub.EndDate.Before(last) {
+		last = *sub.EndDate
+	}
+	if first.After(last) {
+		return 0
+	}
+
+	charges := 0
+	charge := start.AddDate(0, 0, (daysBetween(start, first)+6)/7*7)
+	for ; !charge.After(last); charge = charge.AddDate(0, 0, 7) {
+		if !freeDay(sub, charge) {
+			charges++
+		}
+	}
+
+	return charges
+}
+
+// freeDay tells whether the subscription is not billed on the day because
+// it is on trial or paused.
+func freeDay(sub entities.Subscription, day time.Time) bool {
+	if sub.TrialStartDate != nil && sub.TrialEndDate != nil &&
+		!day.Before(*sub.TrialStartDate) && !day.After(*sub.TrialEndDate) {
+		return true
+	}
+	for _, pause := range sub.Pauses {
+		if pause.Covers(day) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 	"subscription_service/internal/controllers/requests"
 	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
 	"subscription_service/pkg/logger"
 	"time"
 )
@@ -15,35 +16,120 @@ type CalculateTotalCostUseCase interface {
 }
 
 type calculateTotalCostUseCase struct {
-	subRepo CalculateTotalCostRepository
-	logger  logger.Logger
+	subRepo   CalculateTotalCostRepository
+	priceRepo SubPriceHistoryRepository
+	pauseRepo SubPausesRepository
+	rateRepo  ExchangeRatesRepository
+	logger    logger.Logger
 }
 
-func NewCalculateTotalCostUseCase(subRepo CalculateTotalCostRepository, logger logger.Logger) CalculateTotalCostUseCase {
+func NewCalculateTotalCostUseCase(
+	subRepo CalculateTotalCostRepository,
+	priceRepo SubPriceHistoryRepository,
+	pauseRepo SubPausesRepository,
+	rateRepo ExchangeRatesRepository,
+	logger logger.Logger,
+) CalculateTotalCostUseCase {
 	return &calculateTotalCostUseCase{
-		subRepo: subRepo,
-		logger:  logger,
+		subRepo:   subRepo,
+		priceRepo: priceRepo,
+		pauseRepo: pauseRepo,
+		rateRepo:  rateRepo,
+		logger:    logger,
 	}
 }
 
-func (c *calculateTotalCostUseCase) CalculateTotalCost(ginCtx context.Context, req requests.CalculateTotalCost) (responses.CalculateTotalCost, error) {
-	startPeriod, err := time.Parse("01-2006", req.StartPeriod)
+func (c *calculateTotalCostUseCase) CalculateTotalCost(ctx context.Context, req requests.CalculateTotalCost) (responses.CalculateTotalCost, error) {
+	breakdown, err := newCostBreakdown(req.GroupBy)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Invalid group_by value")
+		return responses.CalculateTotalCost{}, errors.Wrap(err, "failed to parse group_by")
+	}
+
+	calculation, err := c.calculate(ctx, req)
+	if err != nil {
+		return responses.CalculateTotalCost{}, err
+	}
+
+	response := responses.CalculateTotalCost{
+		Currency:  calculation.currency,
+		Mode:      calculation.mode,
+		Proration: calculation.proration,
+	}
+	for _, line := range calculation.lines {
+		response.Total += line.total
+		response.UnproratedTotal += line.unprorated
+		if line.billed() {
+			response.Months++
+		}
+		breakdown.add(line)
+	}
+	response.Breakdown = breakdown.items()
+
+	return response, nil
+}
+
+// costLine is what one subscription costs in one billed month, converted to
+// the target currency.
+type costLine struct {
+	sub        entities.Subscription
+	month      int
+	total      int
+	unprorated int
+}
+
+// billed tells whether anything is charged in the month. In charge mode a
+// quarterly or yearly plan is active but free between its renewals.
+func (l costLine) billed() bool {
+	return l.total > 0
+}
+
+// costCalculation holds the cost of every billed month of the subscriptions
+// matching a total cost request, in the order of the subscriptions.
+type costCalculation struct {
+	startPeriod time.Time
+	endPeriod   time.Time
+	currency    string
+	mode        string
+	proration   string
+	lines       []costLine
+}
+
+// parsePeriod reads the bounds of the period of req, both inclusive.
+func (c *calculateTotalCostUseCase) parsePeriod(req requests.CalculateTotalCost) (time.Time, time.Time, error) {
+	startPeriod, err := parseStartDate(req.StartPeriod)
 	if err != nil {
 		c.logger.Error().Err(err).Msg("Invalid start_period format")
-		return responses.CalculateTotalCost{}, errors.Wrap(ErrInvalidDateFormat, "failed to parse start_period")
+		return time.Time{}, time.Time{}, errors.Wrap(err, "failed to parse start_period")
 	}
 
-	endPeriod, err := time.Parse("01-2006", req.EndPeriod)
+	endPeriod, err := parseEndDate(req.EndPeriod)
 	if err != nil {
 		c.logger.Error().Err(err).Msg("Invalid end_period format")
-		return responses.CalculateTotalCost{}, errors.Wrap(ErrInvalidDateFormat, "failed to parse end_period")
+		return time.Time{}, time.Time{}, errors.Wrap(err, "failed to parse end_period")
+	}
+
+	if endPeriod.Before(startPeriod) {
+		c.logger.Error().Msg("end_period is before start_period")
+		return time.Time{}, time.Time{}, errors.Wrap(ErrInvalidPeriod, "end_period is before start_period")
+	}
+
+	return startPeriod, endPeriod, nil
+}
+
+// calculate validates req and prices every month billed in its period. Both
+// the total cost and the cost report are built from its result.
+func (c *calculateTotalCostUseCase) calculate(ctx context.Context, req requests.CalculateTotalCost) (costCalculation, error) {
+	startPeriod, endPeriod, err := c.parsePeriod(req)
+	if err != nil {
+		return costCalculation{}, err
 	}
 
 	var userID *string
 	if req.UserID != "" {
 		if _, err := uuid.Parse(req.UserID); err != nil {
 			c.logger.Error().Err(err).Msg("Invalid user_id format")
-			return responses.CalculateTotalCost{}, errors.Wrap(ErrInvalidUUID, "failed to parse user_id")
+			return costCalculation{}, errors.Wrap(ErrInvalidUUID, "failed to parse user_id")
 		}
 		userID = &req.UserID
 	}
@@ -53,12 +139,167 @@ func (c *calculateTotalCostUseCase) CalculateTotalCost(ginCtx context.Context, r
 		serviceName = &req.ServiceName
 	}
 
-	ctx := ginCtx
-	total, err := c.subRepo.CalculateTotalCost(ctx, startPeriod, endPeriod, userID, serviceName)
+	currency, err := parseCurrency(req.Currency)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Invalid currency")
+		return costCalculation{}, errors.Wrap(err, "failed to parse currency")
+	}
+
+	mode, err := parseCostMode(req.Mode)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Invalid mode")
+		return costCalculation{}, errors.Wrap(err, "failed to parse mode")
+	}
+
+	proration, err := parseProration(req.Proration)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Invalid proration")
+		return costCalculation{}, errors.Wrap(err, "failed to parse proration")
+	}
+
+	subs, err := c.subRepo.SelectByPeriod(ctx, startPeriod, endPeriod, userID, serviceName)
 	if err != nil {
 		c.logger.Error().Err(err).Msg("Failed to calculate total cost")
-		return responses.CalculateTotalCost{}, errors.Wrap(err, "failed to calculate total cost")
+		return costCalculation{}, errors.Wrap(err, "failed to calculate total cost")
+	}
+
+	schedule, err := c.priceSchedule(ctx, subs)
+	if err != nil {
+		return costCalculation{}, err
+	}
+
+	if err := c.attachPauses(ctx, subs); err != nil {
+		return costCalculation{}, err
 	}
 
-	return responses.CalculateTotalCost{Total: total}, nil
+	converter, err := c.currencyConverter(ctx, currency, subs)
+	if err != nil {
+		return costCalculation{}, err
+	}
+
+	calculation := costCalculation{
+		startPeriod: startPeriod,
+		endPeriod:   endPeriod,
+		currency:    currency,
+		mode:        mode,
+		proration:   proration,
+	}
+	for _, sub := range subs {
+		for _, month := range billedMonths(sub, startPeriod, endPeriod) {
+			cost := monthlyCost(sub, month, schedule.priceAt(sub, month), mode)
+			unprorated, err := converter.convert(cost, sub.Currency)
+			if err != nil {
+				c.logger.Error().Err(err).Msg("Failed to convert price")
+				return costCalculation{}, errors.Wrap(err, "failed to convert price")
+			}
+			prorated, err := converter.convert(prorate(cost, sub, month, startPeriod, endPeriod, mode, proration), sub.Currency)
+			if err != nil {
+				c.logger.Error().Err(err).Msg("Failed to convert price")
+				return costCalculation{}, errors.Wrap(err, "failed to convert price")
+			}
+			calculation.lines = append(calculation.lines, costLine{
+				sub:        sub,
+				month:      month,
+				total:      prorated,
+				unprorated: unprorated,
+			})
+		}
+	}
+
+	return calculation, nil
+}
+
+// priceSchedule loads the price changes of subs, so that each month is billed at the price effective then.
+func (c *calculateTotalCostUseCase) priceSchedule(ctx context.Context, subs []entities.Subscription) (priceSchedule, error) {
+	if len(subs) == 0 {
+		return priceSchedule{}, nil
+	}
+
+	subIDs := make([]uuid.UUID, 0, len(subs))
+	for _, sub := range subs {
+		subIDs = append(subIDs, sub.ID)
+	}
+
+	prices, err := c.priceRepo.SelectBySubscriptionIDs(ctx, subIDs)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to get subscription prices")
+		return nil, errors.Wrap(err, "failed to get subscription prices")
+	}
+
+	return newPriceSchedule(prices), nil
+}
+
+// attachPauses loads the pauses of subs, so that paused days are not billed.
+func (c *calculateTotalCostUseCase) attachPauses(ctx context.Context, subs []entities.Subscription) error {
+	if len(subs) == 0 {
+		return nil
+	}
+
+	subIDs := make([]uuid.UUID, 0, len(subs))
+	for _, sub := range subs {
+		subIDs = append(subIDs, sub.ID)
+	}
+
+	pauses, err := c.pauseRepo.SelectBySubscriptionIDs(ctx, subIDs)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to get subscription pauses")
+		return errors.Wrap(err, "failed to get subscription pauses")
+	}
+
+	for i := range subs {
+		for _, pause := range pauses {
+			if pause.SubscriptionID == subs[i].ID {
+				subs[i].Pauses = append(subs[i].Pauses, pause)
+			}
+		}
+	}
+
+	return nil
+}
+
+// currencyConverter loads exchange rates only when some subscription is priced in a currency other than the target.
+func (c *calculateTotalCostUseCase) currencyConverter(ctx context.Context, target string, subs []entities.Subscription) (*currencyConverter, error) {
+	for _, sub := range subs {
+		if sub.Currency == target {
+			continue
+		}
+
+		rates, err := c.rateRepo.SelectAll(ctx)
+		if err != nil {
+			c.logger.Error().Err(err).Msg("Failed to get exchange rates")
+			return nil, errors.Wrap(err, "failed to get exchange rates")
+		}
+		return newCurrencyConverter(target, rates), nil
+	}
+
+	return newCurrencyConverter(target, nil), nil
+}
+
+// billedMonths returns the month indexes of [startPeriod, endPeriod]
+// the subscription is active in, both bounds inclusive. Months spent entirely
+// on trial are not billed.
+func billedMonths(sub entities.Subscription, startPeriod, endPeriod time.Time) []int {
+	from := max(monthIndex(sub.StartDate), monthIndex(startPeriod))
+	to := monthIndex(endPeriod)
+	if sub.EndDate != nil {
+		to = min(to, monthIndex(*sub.EndDate))
+	}
+
+	var months []int
+	for month := from; month <= to; month++ {
+		if active, _ := activeDays(sub, month, startPeriod, endPeriod); active == 0 {
+			continue
+		}
+		months = append(months, month)
+	}
+
+	return months
+}
+
+func monthIndex(t time.Time) int {
+	return t.Year()*12 + int(t.Month()) - 1
+}
+
+func monthStart(index int) time.Time {
+	return time.Date(index/12, time.Month(index%12+1), 1, 0, 0, 0, 0, time.UTC)
 }
@@ -11,16 +11,24 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
 )
 
 var (
-	mockCalculateSubRepo *MockCalculateTotalCostRepository
-	mockLogger           *logger.MockLogger
+	mockCalculateSubRepo   *MockCalculateTotalCostRepository
+	mockCalculatePriceRepo *MockSubPriceHistoryRepository
+	mockCalculatePauseRepo *MockSubPausesRepository
+	mockCalculateRateRepo  *MockExchangeRatesRepository
+	mockLogger             *logger.MockLogger
 )
 
 func initCalculateTotalCostTestMocks(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockCalculateSubRepo = NewMockCalculateTotalCostRepository(ctrl)
+	mockCalculatePriceRepo = NewMockSubPriceHistoryRepository(ctrl)
+	mockCalculatePauseRepo = NewMockSubPausesRepository(ctrl)
+	mockCalculateRateRepo = NewMockExchangeRatesRepository(ctrl)
 	mockLogger = logger.NewMockLogger(t)
 }
 
@@ -28,7 +36,7 @@ func TestCalculateTotalCost_Success(t *testing.T) {
 	initCalculateTotalCostTestMocks(t)
 	ctx := context.Background()
 	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
-	endPeriod, _ := time.Parse("2006-01-02", "2025-12-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-12-31")
 	userID := uuid.New().String()
 	serviceName := "Yandex Plus"
 
@@ -39,33 +47,77 @@ func TestCalculateTotalCost_Success(t *testing.T) {
 		ServiceName: serviceName,
 	}
 
-	mockCalculateSubRepo.EXPECT().CalculateTotalCost(ctx, startPeriod, endPeriod, &userID, &serviceName).Return(400, nil)
+	subs := []entities.Subscription{
+		{
+			ID:          uuid.New(),
+			ServiceName: serviceName,
+			Price:       400,
+			Currency:    entities.DefaultCurrency,
+			UserID:      uuid.MustParse(userID),
+			StartDate:   startPeriod,
+		},
+	}
+	mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, &userID, &serviceName).Return(subs, nil)
+	mockCalculatePriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+	mockCalculatePauseRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	response, err := useCase.CalculateTotalCost(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2400, response.Total)
+	assert.Equal(t, 6, response.Months)
+}
+
+func TestCalculateTotalCost_Success_ClipsByDates(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-12-31")
+	subStart, _ := time.Parse("2006-01-02", "2025-03-01")
+	subEnd, _ := time.Parse("2006-01-02", "2025-08-01")
+	lateStart, _ := time.Parse("2006-01-02", "2025-11-01")
+
+	req := requests.CalculateTotalCost{
+		StartPeriod: "07-2025",
+		EndPeriod:   "12-2025",
+	}
+
+	subs := []entities.Subscription{
+		{ID: uuid.New(), Price: 400, Currency: entities.DefaultCurrency, UserID: uuid.New(), StartDate: subStart, EndDate: &subEnd},
+		{ID: uuid.New(), Price: 300, Currency: entities.DefaultCurrency, UserID: uuid.New(), StartDate: lateStart},
+	}
+	mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return(subs, nil)
+	mockCalculatePriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+	mockCalculatePauseRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
 
-	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockLogger)
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
 	response, err := useCase.CalculateTotalCost(ctx, req)
 
 	assert.NoError(t, err)
-	assert.Equal(t, 400, response.Total)
+	assert.Equal(t, 400*2+300*2, response.Total)
+	assert.Equal(t, 4, response.Months)
 }
 
 func TestCalculateTotalCost_Success_NoFilters(t *testing.T) {
 	initCalculateTotalCostTestMocks(t)
 	ctx := context.Background()
 	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
-	endPeriod, _ := time.Parse("2006-01-02", "2025-12-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-12-31")
 
 	req := requests.CalculateTotalCost{
 		StartPeriod: "07-2025",
 		EndPeriod:   "12-2025",
 	}
 
-	mockCalculateSubRepo.EXPECT().CalculateTotalCost(ctx, startPeriod, endPeriod, nil, nil).Return(700, nil)
+	mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return(nil, nil)
 
-	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockLogger)
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
 	response, err := useCase.CalculateTotalCost(ctx, req)
 
 	assert.NoError(t, err)
-	assert.Equal(t, 700, response.Total)
+	assert.Equal(t, 0, response.Total)
+	assert.Equal(t, 0, response.Months)
 }
 
 func TestCalculateTotalCost_Failure_InvalidStartPeriod(t *testing.T) {
@@ -76,7 +128,7 @@ func TestCalculateTotalCost_Failure_InvalidStartPeriod(t *testing.T) {
 		EndPeriod:   "12-2025",
 	}
 
-	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockLogger)
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
 	_, err := useCase.CalculateTotalCost(ctx, req)
 
 	assert.Error(t, err)
@@ -91,13 +143,28 @@ func TestCalculateTotalCost_Failure_InvalidEndPeriod(t *testing.T) {
 		EndPeriod:   "invalid-date",
 	}
 
-	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockLogger)
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
 	_, err := useCase.CalculateTotalCost(ctx, req)
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, ErrInvalidDateFormat)
 }
 
+func TestCalculateTotalCost_Failure_EndBeforeStart(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	req := requests.CalculateTotalCost{
+		StartPeriod: "12-2025",
+		EndPeriod:   "07-2025",
+	}
+
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	_, err := useCase.CalculateTotalCost(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
+}
+
 func TestCalculateTotalCost_Failure_InvalidUserID(t *testing.T) {
 	initCalculateTotalCostTestMocks(t)
 	ctx := context.Background()
@@ -107,7 +174,7 @@ func TestCalculateTotalCost_Failure_InvalidUserID(t *testing.T) {
 		UserID:      "invalid-uuid",
 	}
 
-	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockLogger)
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
 	_, err := useCase.CalculateTotalCost(ctx, req)
 
 	assert.Error(t, err)
@@ -118,17 +185,512 @@ func TestCalculateTotalCost_Failure_DatabaseError(t *testing.T) {
 	initCalculateTotalCostTestMocks(t)
 	ctx := context.Background()
 	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
-	endPeriod, _ := time.Parse("2006-01-02", "2025-12-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-12-31")
+
+	req := requests.CalculateTotalCost{
+		StartPeriod: "07-2025",
+		EndPeriod:   "12-2025",
+	}
+
+	expectedErr := errors.New("database error")
+	mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return(nil, expectedErr)
+
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	_, err := useCase.CalculateTotalCost(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, expectedErr)
+}
+
+func TestCalculateTotalCost_Success_GroupByServiceAndMonth(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-08-31")
+
+	req := requests.CalculateTotalCost{
+		StartPeriod: "07-2025",
+		EndPeriod:   "08-2025",
+		GroupBy:     []string{GroupByService, GroupByMonth},
+	}
+
+	subs := []entities.Subscription{
+		{ID: uuid.New(), ServiceName: "Yandex Plus", Price: 400, Currency: entities.DefaultCurrency, UserID: uuid.New(), StartDate: startPeriod},
+		{ID: uuid.New(), ServiceName: "Yandex Plus", Price: 400, Currency: entities.DefaultCurrency, UserID: uuid.New(), StartDate: endPeriod},
+		{ID: uuid.New(), ServiceName: "Spotify", Price: 300, Currency: entities.DefaultCurrency, UserID: uuid.New(), StartDate: startPeriod},
+	}
+	mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return(subs, nil)
+	mockCalculatePriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+	mockCalculatePauseRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	response, err := useCase.CalculateTotalCost(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 400*3+300*2, response.Total)
+	assert.Equal(t, []responses.CostBreakdown{
+		{ServiceName: "Spotify", Month: "07-2025", Total: 300, UnproratedTotal: 300, Months: 1},
+		{ServiceName: "Yandex Plus", Month: "07-2025", Total: 400, UnproratedTotal: 400, Months: 1},
+		{ServiceName: "Spotify", Month: "08-2025", Total: 300, UnproratedTotal: 300, Months: 1},
+		{ServiceName: "Yandex Plus", Month: "08-2025", Total: 800, UnproratedTotal: 800, Months: 2},
+	}, response.Breakdown)
+}
+
+func TestCalculateTotalCost_Failure_InvalidGroupBy(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	req := requests.CalculateTotalCost{
+		StartPeriod: "07-2025",
+		EndPeriod:   "12-2025",
+		GroupBy:     []string{"invalid"},
+	}
+
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	_, err := useCase.CalculateTotalCost(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidGroupBy)
+}
+
+func TestCalculateTotalCost_Success_UsesEffectivePrice(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-12-31")
+	subStart, _ := time.Parse("2006-01-02", "2025-01-01")
+	raisedFrom, _ := time.Parse("2006-01-02", "2025-10-01")
+	pastChange, _ := time.Parse("2006-01-02", "2025-03-01")
+
+	req := requests.CalculateTotalCost{
+		StartPeriod: "07-2025",
+		EndPeriod:   "12-2025",
+		GroupBy:     []string{GroupByMonth},
+	}
+
+	sub := entities.Subscription{ID: uuid.New(), Price: 300, Currency: entities.DefaultCurrency, UserID: uuid.New(), StartDate: subStart}
+	prices := []entities.SubscriptionPrice{
+		{SubscriptionID: sub.ID, Price: 500, EffectiveFrom: raisedFrom},
+		{SubscriptionID: sub.ID, Price: 400, EffectiveFrom: pastChange},
+	}
+	mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return([]entities.Subscription{sub}, nil)
+	mockCalculatePriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, []uuid.UUID{sub.ID}).Return(prices, nil)
+	mockCalculatePauseRepo.EXPECT().SelectBySubscriptionIDs(ctx, []uuid.UUID{sub.ID}).Return(nil, nil)
+
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	response, err := useCase.CalculateTotalCost(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 400*3+500*3, response.Total)
+	assert.Equal(t, 6, response.Months)
+	assert.Equal(t, 400, response.Breakdown[2].Total)
+	assert.Equal(t, 500, response.Breakdown[3].Total)
+}
+
+func TestCalculateTotalCost_Failure_PriceHistoryError(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-12-31")
+
+	req := requests.CalculateTotalCost{
+		StartPeriod: "07-2025",
+		EndPeriod:   "12-2025",
+	}
+
+	subs := []entities.Subscription{{ID: uuid.New(), Price: 400, Currency: entities.DefaultCurrency, UserID: uuid.New(), StartDate: startPeriod}}
+	expectedErr := errors.New("database error")
+	mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return(subs, nil)
+	mockCalculatePriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Any()).Return(nil, expectedErr)
+
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	_, err := useCase.CalculateTotalCost(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, expectedErr)
+}
+
+func TestCalculateTotalCost_Success_ConvertsCurrency(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-08-31")
+
+	req := requests.CalculateTotalCost{
+		StartPeriod: "07-2025",
+		EndPeriod:   "08-2025",
+		Currency:    "USD",
+	}
+
+	subs := []entities.Subscription{
+		{ID: uuid.New(), Price: 10, Currency: "USD", UserID: uuid.New(), StartDate: startPeriod},
+		{ID: uuid.New(), Price: 900, Currency: "RUB", UserID: uuid.New(), StartDate: startPeriod},
+		{ID: uuid.New(), Price: 20, Currency: "EUR", UserID: uuid.New(), StartDate: startPeriod},
+	}
+	rates := []entities.ExchangeRate{
+		{Base: "USD", Quote: "RUB", Rate: 90},
+		{Base: "EUR", Quote: "RUB", Rate: 99},
+	}
+	mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return(subs, nil)
+	mockCalculatePriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+	mockCalculatePauseRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+	mockCalculateRateRepo.EXPECT().SelectAll(ctx).Return(rates, nil)
+
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	response, err := useCase.CalculateTotalCost(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "USD", response.Currency)
+	assert.Equal(t, (10+10+22)*2, response.Total)
+}
 
+func TestCalculateTotalCost_Failure_ExchangeRateNotFound(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-08-31")
+
+	req := requests.CalculateTotalCost{
+		StartPeriod: "07-2025",
+		EndPeriod:   "08-2025",
+	}
+
+	subs := []entities.Subscription{{ID: uuid.New(), Price: 10, Currency: "USD", UserID: uuid.New(), StartDate: startPeriod}}
+	mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return(subs, nil)
+	mockCalculatePriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+	mockCalculatePauseRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+	mockCalculateRateRepo.EXPECT().SelectAll(ctx).Return(nil, nil)
+
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	_, err := useCase.CalculateTotalCost(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrExchangeRateNotFound)
+}
+
+func TestCalculateTotalCost_Failure_InvalidCurrency(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
 	req := requests.CalculateTotalCost{
 		StartPeriod: "07-2025",
 		EndPeriod:   "12-2025",
+		Currency:    "XYZ",
+	}
+
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	_, err := useCase.CalculateTotalCost(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidCurrency)
+}
+
+func TestCalculateTotalCost_Success_BillingPeriods(t *testing.T) {
+	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2026-06-30")
+	yearlyStart, _ := time.Parse("2006-01-02", "2025-03-01")
+	quarterlyStart, _ := time.Parse("2006-01-02", "2025-08-01")
+
+	subs := []entities.Subscription{
+		{ID: uuid.New(), Price: 1200, Currency: entities.DefaultCurrency, BillingPeriod: entities.BillingYearly, UserID: uuid.New(), StartDate: yearlyStart},
+		{ID: uuid.New(), Price: 300, Currency: entities.DefaultCurrency, BillingPeriod: entities.BillingQuarterly, UserID: uuid.New(), StartDate: quarterlyStart},
+		{ID: uuid.New(), Price: 100, Currency: entities.DefaultCurrency, BillingPeriod: entities.BillingMonthly, UserID: uuid.New(), StartDate: startPeriod},
+	}
+
+	tests := []struct {
+		mode     string
+		expected int
+		months   int
+	}{
+		// The yearly plan renews in March 2026, the quarterly plan in Aug, Nov, Feb and May.
+		{mode: CostModeCharge, expected: 1200 + 300*4 + 100*12, months: 1 + 4 + 12},
+		// Amortized: 12 months of the yearly plan, 11 months of the quarterly one.
+		{mode: CostModeAmortized, expected: 100*12 + 100*11 + 100*12, months: 12 + 11 + 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			initCalculateTotalCostTestMocks(t)
+			ctx := context.Background()
+			req := requests.CalculateTotalCost{
+				StartPeriod: "07-2025",
+				EndPeriod:   "06-2026",
+				Mode:        tt.mode,
+			}
+
+			mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return(subs, nil)
+			mockCalculatePriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+			mockCalculatePauseRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+
+			useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+			response, err := useCase.CalculateTotalCost(ctx, req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.mode, response.Mode)
+			assert.Equal(t, tt.expected, response.Total)
+			assert.Equal(t, tt.months, response.Months)
+		})
+	}
+}
+
+func TestCalculateTotalCost_Success_WeeklyCharges(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-08-31")
+
+	req := requests.CalculateTotalCost{
+		StartPeriod: "07-2025",
+		EndPeriod:   "08-2025",
+		GroupBy:     []string{GroupByMonth},
+	}
+
+	subs := []entities.Subscription{
+		{ID: uuid.New(), Price: 100, Currency: entities.DefaultCurrency, BillingPeriod: entities.BillingWeekly, UserID: uuid.New(), StartDate: startPeriod},
+	}
+	mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return(subs, nil)
+	mockCalculatePriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+	mockCalculatePauseRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	response, err := useCase.CalculateTotalCost(ctx, req)
+
+	// Charged on July 1, 8, 15, 22, 29 and August 5, 12, 19, 26.
+	assert.NoError(t, err)
+	assert.Equal(t, 900, response.Total)
+	assert.Equal(t, 500, response.Breakdown[0].Total)
+	assert.Equal(t, 400, response.Breakdown[1].Total)
+}
+
+func TestCalculateTotalCost_Failure_InvalidMode(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	req := requests.CalculateTotalCost{
+		StartPeriod: "07-2025",
+		EndPeriod:   "12-2025",
+		Mode:        "invalid",
+	}
+
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	_, err := useCase.CalculateTotalCost(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidCostMode)
+}
+
+func TestCalculateTotalCost_Success_Proration(t *testing.T) {
+	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-09-30")
+	subStart, _ := time.Parse("2006-01-02", "2025-07-21")
+	subEnd, _ := time.Parse("2006-01-02", "2025-09-15")
+
+	subs := []entities.Subscription{
+		{ID: uuid.New(), Price: 310, Currency: entities.DefaultCurrency, BillingPeriod: entities.BillingMonthly, UserID: uuid.New(), StartDate: subStart, EndDate: &subEnd},
+	}
+
+	tests := []struct {
+		proration string
+		expected  int
+	}{
+		{proration: ProrationNone, expected: 310 * 3},
+		// 11 of 31 days in July, all of August, 15 of 30 days in September.
+		{proration: ProrationDaily, expected: 110 + 310 + 155},
+		{proration: ProrationHalfMonth, expected: 155 + 310 + 155},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.proration, func(t *testing.T) {
+			initCalculateTotalCostTestMocks(t)
+			ctx := context.Background()
+			req := requests.CalculateTotalCost{
+				StartPeriod: "07-2025",
+				EndPeriod:   "09-2025",
+				Proration:   tt.proration,
+			}
+
+			mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return(subs, nil)
+			mockCalculatePriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+			mockCalculatePauseRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+
+			useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+			response, err := useCase.CalculateTotalCost(ctx, req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.proration, response.Proration)
+			assert.Equal(t, tt.expected, response.Total)
+			assert.Equal(t, 310*3, response.UnproratedTotal)
+		})
+	}
+}
+
+func TestCalculateTotalCost_Success_ProrationSkipsDiscreteCharges(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-07-31")
+	subStart, _ := time.Parse("2006-01-02", "2025-07-21")
+
+	req := requests.CalculateTotalCost{
+		StartPeriod: "07-2025",
+		EndPeriod:   "07-2025",
+		Proration:   ProrationDaily,
+	}
+
+	subs := []entities.Subscription{
+		{ID: uuid.New(), Price: 1200, Currency: entities.DefaultCurrency, BillingPeriod: entities.BillingYearly, UserID: uuid.New(), StartDate: subStart},
+	}
+	mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return(subs, nil)
+	mockCalculatePriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+	mockCalculatePauseRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	response, err := useCase.CalculateTotalCost(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1200, response.Total)
+	assert.Equal(t, 1200, response.UnproratedTotal)
+}
+
+func TestCalculateTotalCost_Failure_InvalidProration(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	req := requests.CalculateTotalCost{
+		StartPeriod: "07-2025",
+		EndPeriod:   "12-2025",
+		Proration:   "weekly",
+	}
+
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	_, err := useCase.CalculateTotalCost(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidProration)
+}
+
+func TestCalculateTotalCost_Success_ExcludesTrial(t *testing.T) {
+	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-09-30")
+	subStart, _ := time.Parse("2006-01-02", "2025-07-01")
+	trialEnd, _ := time.Parse("2006-01-02", "2025-08-15")
+
+	subs := []entities.Subscription{
+		{ID: uuid.New(), Price: 310, Currency: entities.DefaultCurrency, BillingPeriod: entities.BillingMonthly, UserID: uuid.New(), StartDate: subStart, TrialStartDate: &subStart, TrialEndDate: &trialEnd},
+	}
+
+	tests := []struct {
+		proration string
+		expected  int
+	}{
+		// July is spent on trial entirely, August is partly paid.
+		{proration: ProrationNone, expected: 310 * 2},
+		// 16 of 31 days in August, all of September.
+		{proration: ProrationDaily, expected: 160 + 310},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.proration, func(t *testing.T) {
+			initCalculateTotalCostTestMocks(t)
+			ctx := context.Background()
+			req := requests.CalculateTotalCost{
+				StartPeriod: "07-2025",
+				EndPeriod:   "09-2025",
+				Proration:   tt.proration,
+			}
+
+			mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return(subs, nil)
+			mockCalculatePriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+			mockCalculatePauseRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+
+			useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+			response, err := useCase.CalculateTotalCost(ctx, req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, response.Total)
+			assert.Equal(t, 2, response.Months)
+		})
+	}
+}
+
+func TestCalculateTotalCost_Success_YearlyChargedAfterTrial(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2026-12-31")
+	subStart, _ := time.Parse("2006-01-02", "2025-07-01")
+	trialEnd, _ := time.Parse("2006-01-02", "2025-07-31")
+
+	req := requests.CalculateTotalCost{
+		StartPeriod: "07-2025",
+		EndPeriod:   "12-2026",
+		GroupBy:     []string{GroupByMonth},
+	}
+
+	subs := []entities.Subscription{
+		{ID: uuid.New(), Price: 1200, Currency: entities.DefaultCurrency, BillingPeriod: entities.BillingYearly, UserID: uuid.New(), StartDate: subStart, TrialStartDate: &subStart, TrialEndDate: &trialEnd},
+	}
+	mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return(subs, nil)
+	mockCalculatePriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+	mockCalculatePauseRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	response, err := useCase.CalculateTotalCost(ctx, req)
+
+	assert.NoError(t, err)
+	// Charged in August 2025 and August 2026, right after the trial.
+	assert.Equal(t, 2400, response.Total)
+	var charged []string
+	for _, item := range response.Breakdown {
+		if item.Total > 0 {
+			charged = append(charged, item.Month)
+		}
+	}
+	assert.Equal(t, []string{"08-2025", "08-2026"}, charged)
+}
+
+func TestCalculateTotalCost_Success_ExcludesPausedMonths(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	startPeriod, _ := time.Parse("2006-01-02", "2025-01-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-12-31")
+	pausedFrom, _ := time.Parse("2006-01-02", "2025-06-01")
+	resumedAt, _ := time.Parse("2006-01-02", "2025-09-01")
+
+	req := requests.CalculateTotalCost{
+		StartPeriod: "01-2025",
+		EndPeriod:   "12-2025",
+	}
+
+	sub := entities.Subscription{ID: uuid.New(), Price: 400, Currency: entities.DefaultCurrency, BillingPeriod: entities.BillingMonthly, UserID: uuid.New(), StartDate: startPeriod}
+	pauses := []entities.SubscriptionPause{{SubscriptionID: sub.ID, PausedFrom: pausedFrom, ResumedAt: &resumedAt}}
+	mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return([]entities.Subscription{sub}, nil)
+	mockCalculatePriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, []uuid.UUID{sub.ID}).Return(nil, nil)
+	mockCalculatePauseRepo.EXPECT().SelectBySubscriptionIDs(ctx, []uuid.UUID{sub.ID}).Return(pauses, nil)
+
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	response, err := useCase.CalculateTotalCost(ctx, req)
+
+	assert.NoError(t, err)
+	// June, July and August are paused.
+	assert.Equal(t, 400*9, response.Total)
+	assert.Equal(t, 9, response.Months)
+}
+
+func TestCalculateTotalCost_Failure_PausesError(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	startPeriod, _ := time.Parse("2006-01-02", "2025-01-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-12-31")
+
+	req := requests.CalculateTotalCost{
+		StartPeriod: "01-2025",
+		EndPeriod:   "12-2025",
 	}
 
+	subs := []entities.Subscription{{ID: uuid.New(), Price: 400, Currency: entities.DefaultCurrency, UserID: uuid.New(), StartDate: startPeriod}}
 	expectedErr := errors.New("database error")
-	mockCalculateSubRepo.EXPECT().CalculateTotalCost(ctx, startPeriod, endPeriod, nil, nil).Return(700, expectedErr)
+	mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return(subs, nil)
+	mockCalculatePriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+	mockCalculatePauseRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, expectedErr)
 
-	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockLogger)
+	useCase := NewCalculateTotalCostUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
 	_, err := useCase.CalculateTotalCost(ctx, req)
 
 	assert.Error(t, err)
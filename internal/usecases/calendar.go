@@ -0,0 +1,148 @@
+package usecases
+
+import (
+	"strconv"
+	"strings"
+	"subscription_service/internal/entities"
+	"time"
+)
+
+const (
+	calendarProductID = "-//subscription_service//Subscriptions//RU"
+	calendarName      = "Подписки"
+	// calendarUIDDomain makes event UIDs globally unique as RFC 5545 asks.
+	calendarUIDDomain = "subscription-service"
+	// calendarLineOctets is the longest content line RFC 5545 allows before
+	// it has to be folded.
+	calendarLineOctets = 75
+
+	icsDateLayout     = "20060102"
+	icsDateTimeLayout = "20060102T150405Z"
+)
+
+var billingPeriodNames = map[string]string{
+	entities.BillingWeekly:    "еженедельно",
+	entities.BillingMonthly:   "ежемесячно",
+	entities.BillingQuarterly: "ежеквартально",
+	entities.BillingYearly:    "ежегодно",
+}
+
+var icsTextEscaper = strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// calendarEvent is a renewal of one subscription repeating every billing period.
+type calendarEvent struct {
+	sub   entities.Subscription
+	price int
+}
+
+// calendar renders an RFC 5545 calendar with lines separated by CRLF.
+type calendar struct {
+	builder strings.Builder
+	stamp   time.Time
+}
+
+func newCalendar(stamp time.Time) *calendar {
+	c := &calendar{stamp: stamp.UTC()}
+	c.line("BEGIN:VCALENDAR")
+	c.line("VERSION:2.0")
+	c.line("PRODID:" + calendarProductID)
+	c.line("CALSCALE:GREGORIAN")
+	c.line("METHOD:PUBLISH")
+	c.line("X-WR-CALNAME:" + calendarName)
+
+	return c
+}
+
+// add renders an all-day event on the first paid day of the subscription that
+// recurs every billing period until the end date. The UID depends only on the
+// subscription id and SEQUENCE on its version, so calendar apps update the
+// event in place when the subscription changes.
+func (c *calendar) add(event calendarEvent) {
+	sub := event.sub
+	start := billingStart(sub)
+	summary := sub.ServiceName + " — " + strconv.Itoa(event.price) + " " + sub.Currency
+	description := "Продление подписки " + sub.ServiceName + ": " + strconv.Itoa(event.price) + " " + sub.Currency +
+		", " + billingPeriodNames[sub.BillingPeriod]
+
+	c.line("BEGIN:VEVENT")
+	c.line("UID:" + sub.ID.String() + "@" + calendarUIDDomain)
+	c.line("DTSTAMP:" + c.stamp.Format(icsDateTimeLayout))
+	c.line("SEQUENCE:" + strconv.Itoa(sub.Version))
+	c.line("DTSTART;VALUE=DATE:" + start.Format(icsDateLayout))
+	c.line("DTEND;VALUE=DATE:" + start.AddDate(0, 0, 1).Format(icsDateLayout))
+	c.line("RRULE:" + recurrenceRule(sub.BillingPeriod, start, sub.EndDate))
+	c.line("SUMMARY:" + icsTextEscaper.Replace(summary))
+	c.line("DESCRIPTION:" + icsTextEscaper.Replace(description))
+	c.line("TRANSP:TRANSPARENT")
+	c.line("END:VEVENT")
+}
+
+func (c *calendar) bytes() []byte {
+	c.line("END:VCALENDAR")
+
+	return []byte(c.builder.String())
+}
+
+// line writes a content line, folding it into continuation lines of at most
+// calendarLineOctets octets without splitting a UTF-8 sequence.
+func (c *calendar) line(content string) {
+	limit := calendarLineOctets
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(content[cut]) {
+			cut--
+		}
+		c.builder.WriteString(content[:cut])
+		c.builder.WriteString("\r\n ")
+		content = content[cut:]
+		// the leading space of a continuation line counts towards its length
+		limit = calendarLineOctets - 1
+	}
+	c.builder.WriteString(content)
+	c.builder.WriteString("\r\n")
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+// recurrenceRule repeats an event every billing period starting on start.
+// Monthly renewals past the 28th fall on the last day of shorter months
+// instead of skipping them, as a plain BYMONTHDAY would.
+func recurrenceRule(billingPeriod string, start time.Time, endDate *time.Time) string {
+	var rule string
+	switch billingPeriod {
+	case entities.BillingWeekly:
+		rule = "FREQ=WEEKLY"
+	case entities.BillingQuarterly:
+		rule = "FREQ=MONTHLY;INTERVAL=3" + monthEndDays(start)
+	case entities.BillingYearly:
+		rule = "FREQ=YEARLY"
+		if start.Month() == time.February && start.Day() == 29 {
+			rule += ";BYMONTH=2;BYMONTHDAY=28,29;BYSETPOS=-1"
+		}
+	default:
+		rule = "FREQ=MONTHLY" + monthEndDays(start)
+	}
+
+	if endDate != nil {
+		rule += ";UNTIL=" + endDate.Format(icsDateLayout)
+	}
+
+	return rule
+}
+
+// monthEndDays picks the renewal day, or the last day of a month too short
+// to have it.
+func monthEndDays(start time.Time) string {
+	if start.Day() <= 28 {
+		return ""
+	}
+
+	days := make([]string, 0, 4)
+	for day := 28; day <= start.Day(); day++ {
+		days = append(days, strconv.Itoa(day))
+	}
+
+	return ";BYMONTHDAY=" + strings.Join(days, ",") + ";BYSETPOS=-1"
+}
@@ -0,0 +1,90 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"time"
+)
+
+type cancelSubUseCase struct {
+	subRepo PatchSubRepository
+	logger  logger.Logger
+}
+
+type CancelSubUseCase interface {
+	CancelSubscription(ctx context.Context, subID string, req requests.CancelSubscription) (responses.SubResponse, error)
+}
+
+func NewCancelSubUseCase(subRepo PatchSubRepository, logger logger.Logger) CancelSubUseCase {
+	return &cancelSubUseCase{
+		subRepo: subRepo,
+		logger:  logger,
+	}
+}
+
+// CancelSubscription ends the subscription on the last day of req.Month, the
+// current month by default, and records when and why it was cancelled.
+func (c *cancelSubUseCase) CancelSubscription(ctx context.Context, subID string, req requests.CancelSubscription) (responses.SubResponse, error) {
+	if _, err := uuid.Parse(subID); err != nil {
+		c.logger.Error().Err(err).Msg("Invalid sub_id format")
+		return responses.SubResponse{}, errors.Wrap(ErrInvalidUUID, "failed to parse sub_id")
+	}
+
+	day := today()
+	month := monthIndex(day)
+	if req.Month != "" {
+		cancelMonth, err := parseStartDate(req.Month)
+		if err != nil {
+			c.logger.Error().Err(err).Msg("Invalid month format")
+			return responses.SubResponse{}, errors.Wrap(err, "failed to parse month")
+		}
+		if monthIndex(cancelMonth) < month {
+			c.logger.Error().Msg("Cancel month is in the past")
+			return responses.SubResponse{}, errors.Wrap(ErrInvalidPeriod, "subscription can't be cancelled in a past month")
+		}
+		month = monthIndex(cancelMonth)
+	}
+	endDate := monthStart(month+1).AddDate(0, 0, -1)
+
+	sub, err := c.subRepo.SelectByID(ctx, subID)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to get subscription")
+		return responses.SubResponse{}, errors.Wrap(err, "failed to get subscription")
+	}
+
+	if status := sub.Status(day); status == entities.StatusCancelled || status == entities.StatusExpired {
+		c.logger.Error().Msg("Subscription has already ended")
+		return responses.SubResponse{}, errors.Wrapf(ErrInvalidStatus, "subscription is %s", status)
+	}
+	if endDate.Before(sub.StartDate) {
+		c.logger.Error().Msg("Cancel month is before start_date")
+		return responses.SubResponse{}, errors.Wrap(ErrInvalidPeriod, "subscription can't end before start_date")
+	}
+	if sub.EndDate != nil && sub.EndDate.Before(endDate) {
+		c.logger.Error().Msg("Cancel month is after end_date")
+		return responses.SubResponse{}, errors.Wrap(ErrInvalidPeriod, "subscription already ends before that month")
+	}
+
+	cancelledAt := time.Now().UTC()
+	sub.EndDate = &endDate
+	sub.CancelledAt = &cancelledAt
+	sub.CancelReason = req.Reason
+	changes := entities.SubscriptionChanges{
+		EndDate:      sub.EndDate,
+		CancelledAt:  sub.CancelledAt,
+		CancelReason: &sub.CancelReason,
+	}
+
+	sub.Version, err = c.subRepo.Patch(ctx, subID, sub.Version, changes)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to cancel subscription")
+		return responses.SubResponse{}, errors.Wrap(err, "failed to cancel subscription")
+	}
+
+	return toSubResponse(ctx, sub), nil
+}
@@ -0,0 +1,151 @@
+package usecases
+
+import (
+	"context"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockCancelSubRepo *MockPatchSubRepository
+)
+
+func initCancelSubTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCancelSubRepo = NewMockPatchSubRepository(ctrl)
+	mockLogger = logger.NewMockLogger(t)
+}
+
+func cancelTestSubscription() entities.Subscription {
+	startDate, _ := time.Parse("2006-01-02", "2025-01-01")
+	return entities.Subscription{ID: uuid.New(), Price: 400, UserID: uuid.New(), StartDate: startDate, Version: 2}
+}
+
+func TestCancelSubscription_Success_CurrentMonth(t *testing.T) {
+	initCancelSubTestMocks(t)
+	ctx := WithDateFormat(context.Background(), DateFormatISO)
+	sub := cancelTestSubscription()
+	endOfMonth := monthStart(monthIndex(today())+1).AddDate(0, 0, -1)
+
+	mockCancelSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+	mockCancelSubRepo.EXPECT().Patch(ctx, sub.ID.String(), 2, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _ int, changes entities.SubscriptionChanges) (int, error) {
+			assert.Equal(t, endOfMonth, *changes.EndDate)
+			assert.NotNil(t, changes.CancelledAt)
+			assert.Equal(t, "too expensive", *changes.CancelReason)
+			return 3, nil
+		})
+
+	useCase := NewCancelSubUseCase(mockCancelSubRepo, mockLogger)
+	response, err := useCase.CancelSubscription(ctx, sub.ID.String(), requests.CancelSubscription{Reason: "too expensive"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, endOfMonth.Format("2006-01-02"), response.EndDate)
+	assert.Equal(t, entities.StatusCancelled, response.Status)
+	assert.Equal(t, "too expensive", response.CancelReason)
+	assert.Equal(t, 3, response.Version)
+}
+
+func TestCancelSubscription_Success_ChosenMonth(t *testing.T) {
+	initCancelSubTestMocks(t)
+	ctx := context.Background()
+	sub := cancelTestSubscription()
+	month := monthStart(monthIndex(today()) + 2)
+
+	mockCancelSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+	mockCancelSubRepo.EXPECT().Patch(ctx, sub.ID.String(), 2, gomock.Any()).Return(3, nil)
+
+	useCase := NewCancelSubUseCase(mockCancelSubRepo, mockLogger)
+	response, err := useCase.CancelSubscription(ctx, sub.ID.String(), requests.CancelSubscription{Month: month.Format("01-2006")})
+
+	assert.NoError(t, err)
+	assert.Equal(t, month.Format("01-2006"), response.EndDate)
+}
+
+func TestCancelSubscription_Failure_PastMonth(t *testing.T) {
+	initCancelSubTestMocks(t)
+	ctx := context.Background()
+
+	useCase := NewCancelSubUseCase(mockCancelSubRepo, mockLogger)
+	_, err := useCase.CancelSubscription(ctx, uuid.New().String(), requests.CancelSubscription{Month: "01-2020"})
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
+}
+
+func TestCancelSubscription_Failure_AlreadyExpired(t *testing.T) {
+	initCancelSubTestMocks(t)
+	ctx := context.Background()
+	sub := cancelTestSubscription()
+	endDate, _ := time.Parse("2006-01-02", "2025-03-31")
+	sub.EndDate = &endDate
+
+	mockCancelSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+
+	useCase := NewCancelSubUseCase(mockCancelSubRepo, mockLogger)
+	_, err := useCase.CancelSubscription(ctx, sub.ID.String(), requests.CancelSubscription{})
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidStatus)
+}
+
+func TestCancelSubscription_Failure_VersionMismatch(t *testing.T) {
+	initCancelSubTestMocks(t)
+	ctx := context.Background()
+	sub := cancelTestSubscription()
+
+	mockCancelSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+	mockCancelSubRepo.EXPECT().Patch(ctx, sub.ID.String(), 2, gomock.Any()).Return(0, ErrVersionMismatch)
+
+	useCase := NewCancelSubUseCase(mockCancelSubRepo, mockLogger)
+	_, err := useCase.CancelSubscription(ctx, sub.ID.String(), requests.CancelSubscription{})
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrVersionMismatch)
+}
+
+func TestCancelSubscription_Failure_InvalidSubID(t *testing.T) {
+	initCancelSubTestMocks(t)
+	ctx := context.Background()
+
+	useCase := NewCancelSubUseCase(mockCancelSubRepo, mockLogger)
+	_, err := useCase.CancelSubscription(ctx, "invalid-uuid", requests.CancelSubscription{})
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidUUID)
+}
+
+func TestSubscriptionStatus(t *testing.T) {
+	day, _ := time.Parse("2006-01-02", "2025-07-15")
+	past, _ := time.Parse("2006-01-02", "2025-01-01")
+	future, _ := time.Parse("2006-01-02", "2025-12-01")
+	monthEnd, _ := time.Parse("2006-01-02", "2025-07-31")
+	cancelledAt, _ := time.Parse("2006-01-02", "2025-07-10")
+	trialEnd, _ := time.Parse("2006-01-02", "2025-07-20")
+
+	tests := []struct {
+		name     string
+		sub      entities.Subscription
+		expected string
+	}{
+		{name: "scheduled", sub: entities.Subscription{StartDate: future}, expected: entities.StatusScheduled},
+		{name: "active", sub: entities.Subscription{StartDate: past}, expected: entities.StatusActive},
+		{name: "trial", sub: entities.Subscription{StartDate: past, TrialStartDate: &past, TrialEndDate: &trialEnd}, expected: entities.StatusTrial},
+		{name: "paused", sub: entities.Subscription{StartDate: past, LastPause: &entities.SubscriptionPause{PausedFrom: past}}, expected: entities.StatusPaused},
+		{name: "cancelled", sub: entities.Subscription{StartDate: past, EndDate: &monthEnd, CancelledAt: &cancelledAt}, expected: entities.StatusCancelled},
+		{name: "expired", sub: entities.Subscription{StartDate: past, EndDate: &cancelledAt}, expected: entities.StatusExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.sub.Status(day))
+		})
+	}
+}
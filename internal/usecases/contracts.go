@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"context"
+	"github.com/google/uuid"
 	"subscription_service/internal/entities"
 	"time"
 )
@@ -13,21 +14,124 @@ type CreateSubRepository interface {
 }
 
 type DeleteSubRepository interface {
-	Delete(ctx context.Context, subID string) error
+	Delete(ctx context.Context, subID string, version int) error
 }
 
 type UpdateSubRepository interface {
+	SelectByID(ctx context.Context, subID string) (entities.Subscription, error)
 	Update(ctx context.Context, sub *entities.Subscription) error
 }
 
+type PatchSubRepository interface {
+	SelectByID(ctx context.Context, subID string) (entities.Subscription, error)
+	Patch(ctx context.Context, subID string, version int, changes entities.SubscriptionChanges) (int, error)
+}
+
 type GetSubRepository interface {
 	SelectByID(ctx context.Context, subID string) (entities.Subscription, error)
 }
 
 type GetAllSubsRepository interface {
-	SelectAll(ctx context.Context, limit, offset int) ([]entities.Subscription, error)
+	SelectAll(ctx context.Context, filter entities.SubscriptionFilter, sort entities.SubscriptionSort, page entities.Page) ([]entities.Subscription, error)
+	Count(ctx context.Context, filter entities.SubscriptionFilter) (int, error)
+}
+
+type ExportSubsRepository interface {
+	Stream(ctx context.Context, filter entities.SubscriptionFilter, sort entities.SubscriptionSort, fn func(entities.Subscription) error) error
+}
+
+type UserCalendarRepository interface {
+	Stream(ctx context.Context, filter entities.SubscriptionFilter, sort entities.SubscriptionSort, fn func(entities.Subscription) error) error
+}
+
+type StatementSubsRepository interface {
+	Stream(ctx context.Context, filter entities.SubscriptionFilter, sort entities.SubscriptionSort, fn func(entities.Subscription) error) error
 }
 
 type CalculateTotalCostRepository interface {
-	CalculateTotalCost(ctx context.Context, startPeriod, endPeriod time.Time, userID *string, serviceName *string) (int, error)
+	SelectByPeriod(ctx context.Context, startPeriod, endPeriod time.Time, userID *string, serviceName *string) ([]entities.Subscription, error)
+}
+
+type IdempotencyRepository interface {
+	SelectByKey(ctx context.Context, key string) (entities.IdempotencyRecord, error)
+	Insert(ctx context.Context, record entities.IdempotencyRecord) error
+}
+
+type SubPriceHistoryRepository interface {
+	SelectBySubscriptionIDs(ctx context.Context, subIDs []uuid.UUID) ([]entities.SubscriptionPrice, error)
+}
+
+type AddSubPriceRepository interface {
+	AddPrice(ctx context.Context, price entities.SubscriptionPrice) error
+	SelectByID(ctx context.Context, subID string) (entities.Subscription, error)
+}
+
+type ExchangeRatesRepository interface {
+	SelectAll(ctx context.Context) ([]entities.ExchangeRate, error)
+}
+
+type LoadExchangeRatesRepository interface {
+	Upsert(ctx context.Context, rates []entities.ExchangeRate) error
+}
+
+type EndingTrialsRepository interface {
+	SelectTrialsEnding(ctx context.Context, from, to time.Time) ([]entities.Subscription, error)
+}
+
+type SubPausesRepository interface {
+	SelectBySubscriptionIDs(ctx context.Context, subIDs []uuid.UUID) ([]entities.SubscriptionPause, error)
+}
+
+type PauseSubRepository interface {
+	Pause(ctx context.Context, pause entities.SubscriptionPause) error
+	SelectByID(ctx context.Context, subID string) (entities.Subscription, error)
+}
+
+type ResumeSubRepository interface {
+	Resume(ctx context.Context, subID uuid.UUID, resumedAt time.Time) error
+	SelectByID(ctx context.Context, subID string) (entities.Subscription, error)
+}
+
+type RestoreSubRepository interface {
+	Restore(ctx context.Context, subID string) error
+	SelectByID(ctx context.Context, subID string) (entities.Subscription, error)
+}
+
+type PurgeSubsRepository interface {
+	Purge(ctx context.Context, deletedBefore time.Time) (int, error)
+}
+
+type AuditLogRepository interface {
+	SelectAll(ctx context.Context, filter entities.AuditFilter) ([]entities.AuditEntry, error)
+}
+
+// TxManager makes the repository calls made with the ctx passed to fn one
+// transaction, committed only when fn returns nil.
+type TxManager interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type BatchSubRepository interface {
+	SelectByID(ctx context.Context, subID string) (entities.Subscription, error)
+	Insert(ctx context.Context, sub *entities.Subscription) error
+	InsertBatch(ctx context.Context, subs []entities.Subscription) error
+	Update(ctx context.Context, sub *entities.Subscription) error
+	Delete(ctx context.Context, subID string, version int) error
+}
+
+type ImportSubsRepository interface {
+	InsertBatch(ctx context.Context, subs []entities.Subscription) error
+}
+
+type ImportStatementRepository interface {
+	Upsert(ctx context.Context, suggestions []entities.SubscriptionSuggestion) error
+}
+
+type GetSuggestionsRepository interface {
+	SelectByUser(ctx context.Context, userID uuid.UUID) ([]entities.SubscriptionSuggestion, error)
+}
+
+type SuggestionStatusRepository interface {
+	SelectByID(ctx context.Context, userID, suggestionID uuid.UUID) (entities.SubscriptionSuggestion, error)
+	SetStatus(ctx context.Context, suggestionID uuid.UUID, status string, subID *uuid.UUID) error
 }
@@ -0,0 +1,110 @@
+package usecases
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"subscription_service/internal/controllers/responses"
+)
+
+const (
+	GroupByService = "service"
+	GroupByUser    = "user"
+	GroupByMonth   = "month"
+)
+
+type costKey struct {
+	serviceName string
+	userID      string
+	month       int
+}
+
+// costBreakdown accumulates billed amounts grouped by any combination of
+// service, user and calendar month.
+type costBreakdown struct {
+	byService bool
+	byUser    bool
+	byMonth   bool
+	totals    map[costKey]*responses.CostBreakdown
+}
+
+func newCostBreakdown(groupBy []string) (*costBreakdown, error) {
+	b := &costBreakdown{totals: make(map[costKey]*responses.CostBreakdown)}
+	for _, group := range groupBy {
+		switch group {
+		case GroupByService:
+			b.byService = true
+		case GroupByUser:
+			b.byUser = true
+		case GroupByMonth:
+			b.byMonth = true
+		default:
+			return nil, ErrInvalidGroupBy
+		}
+	}
+
+	return b, nil
+}
+
+func (b *costBreakdown) enabled() bool {
+	return b.byService || b.byUser || b.byMonth
+}
+
+func (b *costBreakdown) add(line costLine) {
+	if !b.enabled() {
+		return
+	}
+
+	key := costKey{month: -1}
+	item := responses.CostBreakdown{}
+	if b.byService {
+		key.serviceName = line.sub.ServiceName
+		item.ServiceName = line.sub.ServiceName
+	}
+	if b.byUser {
+		key.userID = line.sub.UserID.String()
+		item.UserID = key.userID
+	}
+	if b.byMonth {
+		key.month = line.month
+		item.Month = monthStart(line.month).Format(monthLayout)
+	}
+
+	total, ok := b.totals[key]
+	if !ok {
+		total = &item
+		b.totals[key] = total
+	}
+	total.Total += line.total
+	total.UnproratedTotal += line.unprorated
+	if line.billed() {
+		total.Months++
+	}
+}
+
+// items returns the accumulated groups ordered by month, service and user,
+// or nil when no grouping was requested.
+func (b *costBreakdown) items() []responses.CostBreakdown {
+	if !b.enabled() {
+		return nil
+	}
+
+	keys := make([]costKey, 0, len(b.totals))
+	for key := range b.totals {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b costKey) int {
+		return cmp.Or(
+			cmp.Compare(a.month, b.month),
+			strings.Compare(a.serviceName, b.serviceName),
+			strings.Compare(a.userID, b.userID),
+		)
+	})
+
+	items := make([]responses.CostBreakdown, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, *b.totals[key])
+	}
+
+	return items
+}
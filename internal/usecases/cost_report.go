@@ -0,0 +1,272 @@
+package usecases
+
+import (
+	"context"
+	"io"
+	"slices"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	costReportItemsSheet   = "Подписки"
+	costReportSummarySheet = "Итоги"
+	costReportTotalLabel   = "Итого"
+	// maxCostReportMonths keeps the summary sheet, which has a column per
+	// month besides the service and total ones, within the XLSX column limit.
+	maxCostReportMonths = excelize.MaxColumns - 2
+)
+
+type CostReportUseCase interface {
+	CostReport(ctx context.Context, req requests.CalculateTotalCost, w io.Writer) error
+}
+
+type costReportUseCase struct {
+	calculator *calculateTotalCostUseCase
+	logger     logger.Logger
+}
+
+func NewCostReportUseCase(
+	subRepo CalculateTotalCostRepository,
+	priceRepo SubPriceHistoryRepository,
+	pauseRepo SubPausesRepository,
+	rateRepo ExchangeRatesRepository,
+	logger logger.Logger,
+) CostReportUseCase {
+	return &costReportUseCase{
+		calculator: &calculateTotalCostUseCase{
+			subRepo:   subRepo,
+			priceRepo: priceRepo,
+			pauseRepo: pauseRepo,
+			rateRepo:  rateRepo,
+			logger:    logger,
+		},
+		logger: logger,
+	}
+}
+
+// costReportItem is the cost of one subscription over the whole period.
+type costReportItem struct {
+	id          string
+	serviceName string
+	userID      string
+	months      int
+	total       int
+}
+
+// CostReport writes the total cost of req as an XLSX workbook: one sheet
+// lists what every subscription cost over the period, the other totals the
+// cost per service and per month. The layout is fixed, so group_by is
+// rejected.
+func (c *costReportUseCase) CostReport(ctx context.Context, req requests.CalculateTotalCost, w io.Writer) error {
+	if len(req.GroupBy) > 0 {
+		c.logger.Error().Msg("group_by isn't supported by the cost report")
+		return errors.Wrap(ErrInvalidGroupBy, "group_by isn't supported by the cost report")
+	}
+
+	startPeriod, endPeriod, err := c.calculator.parsePeriod(req)
+	if err != nil {
+		return err
+	}
+	if monthIndex(endPeriod)-monthIndex(startPeriod)+1 > maxCostReportMonths {
+		c.logger.Error().Msg("Cost report period is too long")
+		return errors.Wrapf(ErrInvalidPeriod, "cost report period can't be longer than %d months", maxCostReportMonths)
+	}
+
+	calculation, err := c.calculator.calculate(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	file, err := buildCostReport(calculation)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to build cost report")
+		return errors.Wrap(err, "failed to build cost report")
+	}
+	defer file.Close()
+
+	if err := file.Write(w); err != nil {
+		c.logger.Error().Err(err).Msg("Failed to write cost report")
+		return errors.Wrap(err, "failed to write cost report")
+	}
+
+	return nil
+}
+
+func buildCostReport(calculation costCalculation) (*excelize.File, error) {
+	file := excelize.NewFile()
+	if err := file.SetSheetName(file.GetSheetName(0), costReportItemsSheet); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if _, err := file.NewSheet(costReportSummarySheet); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	header, err := file.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	if err := writeCostReportItems(file, header, calculation); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if err := writeCostReportSummary(file, header, calculation); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// writeCostReportItems fills the sheet with one row per subscription billed
+// in the period, followed by the grand total.
+func writeCostReportItems(file *excelize.File, header int, calculation costCalculation) error {
+	var items []*costReportItem
+	byID := make(map[uuid.UUID]*costReportItem)
+	for _, line := range calculation.lines {
+		item, ok := byID[line.sub.ID]
+		if !ok {
+			item = &costReportItem{
+				id:          line.sub.ID.String(),
+				serviceName: line.sub.ServiceName,
+				userID:      line.sub.UserID.String(),
+			}
+			byID[line.sub.ID] = item
+			items = append(items, item)
+		}
+		if line.billed() {
+			item.months++
+		}
+		item.total += line.total
+	}
+
+	rows := [][]any{{"ID подписки", "Сервис", "Пользователь", "Месяцев оплачено", "Сумма, " + calculation.currency}}
+	months, total := 0, 0
+	for _, item := range items {
+		rows = append(rows, []any{item.id, item.serviceName, item.userID, item.months, item.total})
+		months += item.months
+		total += item.total
+	}
+	rows = append(rows, []any{costReportTotalLabel, nil, nil, months, total})
+
+	if err := writeCostReportRows(file, costReportItemsSheet, rows); err != nil {
+		return err
+	}
+	if err := file.SetColWidth(costReportItemsSheet, "A", "C", 38); err != nil {
+		return err
+	}
+	if err := file.SetColWidth(costReportItemsSheet, "D", "E", 18); err != nil {
+		return err
+	}
+
+	return styleCostReportTotals(file, costReportItemsSheet, header, len(rows), len(rows[0]))
+}
+
+// writeCostReportSummary fills the sheet with a service by month table whose
+// last column and last row hold the totals per service and per month.
+func writeCostReportSummary(file *excelize.File, header int, calculation costCalculation) error {
+	firstMonth, lastMonth := monthIndex(calculation.startPeriod), monthIndex(calculation.endPeriod)
+	months := lastMonth - firstMonth + 1
+
+	var services []string
+	byService := make(map[string][]int)
+	monthTotals := make([]int, months+1)
+	for _, line := range calculation.lines {
+		totals, ok := byService[line.sub.ServiceName]
+		if !ok {
+			totals = make([]int, months+1)
+			byService[line.sub.ServiceName] = totals
+			services = append(services, line.sub.ServiceName)
+		}
+		column := line.month - firstMonth
+		totals[column] += line.total
+		totals[months] += line.total
+		monthTotals[column] += line.total
+		monthTotals[months] += line.total
+	}
+	slices.Sort(services)
+
+	headerRow := []any{"Сервис"}
+	for month := firstMonth; month <= lastMonth; month++ {
+		headerRow = append(headerRow, monthStart(month).Format(monthLayout))
+	}
+	headerRow = append(headerRow, costReportTotalLabel+", "+calculation.currency)
+
+	rows := [][]any{headerRow}
+	for _, service := range services {
+		rows = append(rows, costReportAmounts(service, byService[service]))
+	}
+	rows = append(rows, costReportAmounts(costReportTotalLabel, monthTotals))
+
+	if err := writeCostReportRows(file, costReportSummarySheet, rows); err != nil {
+		return err
+	}
+	if err := file.SetColWidth(costReportSummarySheet, "A", "A", 30); err != nil {
+		return err
+	}
+
+	return styleCostReportTotals(file, costReportSummarySheet, header, len(rows), len(headerRow))
+}
+
+func costReportAmounts(label string, amounts []int) []any {
+	row := make([]any, 0, len(amounts)+1)
+	row = append(row, label)
+	for _, amount := range amounts {
+		row = append(row, amount)
+	}
+
+	return row
+}
+
+func writeCostReportRows(file *excelize.File, sheet string, rows [][]any) error {
+	for i, row := range rows {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		if err != nil {
+			return err
+		}
+		if err := file.SetSheetRow(sheet, cell, &row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// styleCostReportTotals makes the header and the totals row bold and keeps
+// the header visible while scrolling.
+func styleCostReportTotals(file *excelize.File, sheet string, style, rows, columns int) error {
+	last, err := excelize.CoordinatesToCellName(columns, 1)
+	if err != nil {
+		return err
+	}
+	if err := file.SetCellStyle(sheet, "A1", last, style); err != nil {
+		return err
+	}
+
+	first, err := excelize.CoordinatesToCellName(1, rows)
+	if err != nil {
+		return err
+	}
+	last, err = excelize.CoordinatesToCellName(columns, rows)
+	if err != nil {
+		return err
+	}
+	if err := file.SetCellStyle(sheet, first, last, style); err != nil {
+		return err
+	}
+
+	return file.SetPanes(sheet, &excelize.Panes{
+		Freeze:      true,
+		YSplit:      1,
+		TopLeftCell: "A2",
+		ActivePane:  "bottomLeft",
+	})
+}
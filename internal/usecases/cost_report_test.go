@@ -0,0 +1,170 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/entities"
+)
+
+func TestCostReport_Success(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-08-31")
+
+	req := requests.CalculateTotalCost{
+		StartPeriod: "07-2025",
+		EndPeriod:   "08-2025",
+	}
+
+	userID := uuid.MustParse("6060ffee-2bf1-4721-ae6f-7636e979a0cb")
+	subs := []entities.Subscription{
+		{ID: uuid.New(), ServiceName: "Yandex Plus", Price: 400, Currency: entities.DefaultCurrency, UserID: userID, StartDate: startPeriod},
+		{ID: uuid.New(), ServiceName: "Yandex Plus", Price: 400, Currency: entities.DefaultCurrency, UserID: uuid.New(), StartDate: endPeriod},
+		{ID: uuid.New(), ServiceName: "Spotify", Price: 300, Currency: entities.DefaultCurrency, UserID: uuid.New(), StartDate: startPeriod},
+	}
+	mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return(subs, nil)
+	mockCalculatePriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+	mockCalculatePauseRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Len(len(subs))).Return(nil, nil)
+
+	var buf bytes.Buffer
+	useCase := NewCostReportUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	err := useCase.CostReport(ctx, req, &buf)
+	assert.NoError(t, err)
+
+	file, err := excelize.OpenReader(&buf)
+	assert.NoError(t, err)
+	defer file.Close()
+	assert.Equal(t, []string{costReportItemsSheet, costReportSummarySheet}, file.GetSheetList())
+
+	items, err := file.GetRows(costReportItemsSheet)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"ID подписки", "Сервис", "Пользователь", "Месяцев оплачено", "Сумма, RUB"},
+		{subs[0].ID.String(), "Yandex Plus", userID.String(), "2", "800"},
+		{subs[1].ID.String(), "Yandex Plus", subs[1].UserID.String(), "1", "400"},
+		{subs[2].ID.String(), "Spotify", subs[2].UserID.String(), "2", "600"},
+		{"Итого", "", "", "5", "1800"},
+	}, items)
+
+	summary, err := file.GetRows(costReportSummarySheet)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"Сервис", "07-2025", "08-2025", "Итого, RUB"},
+		{"Spotify", "300", "300", "600"},
+		{"Yandex Plus", "400", "800", "1200"},
+		{"Итого", "700", "1100", "1800"},
+	}, summary)
+}
+
+func TestCostReport_Success_EmptyPeriod(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-07-31")
+
+	req := requests.CalculateTotalCost{
+		StartPeriod: "07-2025",
+		EndPeriod:   "07-2025",
+	}
+
+	mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return(nil, nil)
+
+	var buf bytes.Buffer
+	useCase := NewCostReportUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	err := useCase.CostReport(ctx, req, &buf)
+	assert.NoError(t, err)
+
+	file, err := excelize.OpenReader(&buf)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	summary, err := file.GetRows(costReportSummarySheet)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"Сервис", "07-2025", "Итого, RUB"},
+		{"Итого", "0", "0"},
+	}, summary)
+}
+
+func TestCostReport_Failure_InvalidPeriod(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+
+	req := requests.CalculateTotalCost{
+		StartPeriod: "12-2025",
+		EndPeriod:   "07-2025",
+	}
+
+	var buf bytes.Buffer
+	useCase := NewCostReportUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	err := useCase.CostReport(context.Background(), req, &buf)
+
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
+	assert.Zero(t, buf.Len())
+}
+
+func TestCostReport_Failure_PeriodTooLong(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+
+	// The period is rejected before any subscription is read.
+	req := requests.CalculateTotalCost{
+		StartPeriod: "01-2000",
+		EndPeriod:   "12-3400",
+	}
+
+	var buf bytes.Buffer
+	useCase := NewCostReportUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	err := useCase.CostReport(ctx, req, &buf)
+
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
+	assert.Zero(t, buf.Len())
+}
+
+func TestCostReport_Failure_GroupBy(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+
+	req := requests.CalculateTotalCost{
+		StartPeriod: "07-2025",
+		EndPeriod:   "12-2025",
+		GroupBy:     []string{GroupByService},
+	}
+
+	var buf bytes.Buffer
+	useCase := NewCostReportUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	err := useCase.CostReport(context.Background(), req, &buf)
+
+	assert.ErrorIs(t, err, ErrInvalidGroupBy)
+	assert.Zero(t, buf.Len())
+}
+
+func TestCostReport_Failure_DatabaseError(t *testing.T) {
+	initCalculateTotalCostTestMocks(t)
+	ctx := context.Background()
+	startPeriod, _ := time.Parse("2006-01-02", "2025-07-01")
+	endPeriod, _ := time.Parse("2006-01-02", "2025-12-31")
+
+	req := requests.CalculateTotalCost{
+		StartPeriod: "07-2025",
+		EndPeriod:   "12-2025",
+	}
+
+	expectedErr := errors.New("database error")
+	mockCalculateSubRepo.EXPECT().SelectByPeriod(ctx, startPeriod, endPeriod, nil, nil).Return(nil, expectedErr)
+
+	var buf bytes.Buffer
+	useCase := NewCostReportUseCase(mockCalculateSubRepo, mockCalculatePriceRepo, mockCalculatePauseRepo, mockCalculateRateRepo, mockLogger)
+	err := useCase.CostReport(ctx, req, &buf)
+
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Zero(t, buf.Len())
+}
@@ -2,10 +2,14 @@ package usecases
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"subscription_service/internal/controllers/requests"
 	"subscription_service/internal/controllers/responses"
 	"subscription_service/internal/entities"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -13,69 +17,224 @@ import (
 )
 
 type createSubUseCase struct {
-	subRepo CreateSubRepository
-	logger  logger.Logger
+	subRepo         CreateSubRepository
+	idempotencyRepo IdempotencyRepository
+	txManager       TxManager
+	idempotencyTTL  time.Duration
+	logger          logger.Logger
 }
 
 type CreateSubUseCase interface {
-	CreateSubscription(ctx context.Context, req requests.SubRequest) (responses.SubResponse, error)
+	CreateSubscription(ctx context.Context, req requests.SubRequest, idempotencyKey string) (responses.SubResponse, error)
 }
 
-func NewCreateSubUseCase(subRepo CreateSubRepository, logger logger.Logger) CreateSubUseCase {
+func NewCreateSubUseCase(
+	subRepo CreateSubRepository,
+	idempotencyRepo IdempotencyRepository,
+	txManager TxManager,
+	idempotencyTTL time.Duration,
+	logger logger.Logger,
+) CreateSubUseCase {
 	return &createSubUseCase{
-		subRepo: subRepo,
-		logger:  logger,
+		subRepo:         subRepo,
+		idempotencyRepo: idempotencyRepo,
+		txManager:       txManager,
+		idempotencyTTL:  idempotencyTTL,
+		logger:          logger,
 	}
 }
 
-func (c *createSubUseCase) CreateSubscription(ctx context.Context, req requests.SubRequest) (responses.SubResponse, error) {
+// CreateSubscription creates a new subscription. When idempotencyKey is set,
+// a repeated request with the same key and body replays the stored result
+// instead of inserting a duplicate row.
+func (c *createSubUseCase) CreateSubscription(ctx context.Context, req requests.SubRequest, idempotencyKey string) (responses.SubResponse, error) {
+	if idempotencyKey == "" {
+		sub, err := c.createSubscription(ctx, req)
+		if err != nil {
+			return responses.SubResponse{}, err
+		}
+		return toSubResponse(ctx, sub), nil
+	}
+
+	requestHash, err := hashRequest(req)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("Failed to hash request")
+		return responses.SubResponse{}, errors.Wrap(err, "failed to hash request")
+	}
+
+	record, err := c.idempotencyRepo.SelectByKey(ctx, idempotencyKey)
+	switch {
+	case err == nil:
+		return c.replay(ctx, record, requestHash)
+	case !errors.Is(err, ErrEntityNotFound):
+		c.logger.Error().Err(err).Msg("Failed to get idempotency key")
+		return responses.SubResponse{}, errors.Wrap(err, "failed to get idempotency key")
+	}
+
+	// The subscription and the key are stored together, so a failure to save
+	// the key doesn't leave a subscription a retry would duplicate. A
+	// concurrent request with the same key that commits first makes the key
+	// insert fail; the transaction is rolled back and that request's result
+	// is replayed instead.
+	var sub entities.Subscription
+	err = c.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		sub, err = c.createSubscription(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		// The entity is stored rather than the response so that the replay carries the version for the ETag.
+		stored, err := json.Marshal(sub)
+		if err != nil {
+			c.logger.Error().Err(err).Msg("Failed to marshal subscription")
+			return errors.Wrap(err, "failed to marshal subscription")
+		}
+
+		err = c.idempotencyRepo.Insert(ctx, entities.IdempotencyRecord{
+			Key:         idempotencyKey,
+			RequestHash: requestHash,
+			Response:    stored,
+			ExpiresAt:   time.Now().Add(c.idempotencyTTL),
+		})
+		if err != nil {
+			c.logger.Error().Err(err).Msg("Failed to save idempotency key")
+			return errors.Wrap(err, "failed to save idempotency key")
+		}
+
+		return nil
+	})
+	if errors.Is(err, ErrIdempotencyKeyInUse) {
+		record, err := c.idempotencyRepo.SelectByKey(ctx, idempotencyKey)
+		switch {
+		case err == nil:
+			return c.replay(ctx, record, requestHash)
+		case errors.Is(err, ErrEntityNotFound):
+			// the concurrent record expired before it could be read
+			return responses.SubResponse{}, errors.Wrap(ErrIdempotencyKeyInUse, "idempotency key record expired")
+		default:
+			c.logger.Error().Err(err).Msg("Failed to get idempotency key")
+			return responses.SubResponse{}, errors.Wrap(err, "failed to get idempotency key")
+		}
+	}
+	if err != nil {
+		return responses.SubResponse{}, err
+	}
+
+	return toSubResponse(ctx, sub), nil
+}
+
+// replay returns the stored response if the request body matches the one the key was first used with.
+func (c *createSubUseCase) replay(ctx context.Context, record entities.IdempotencyRecord, requestHash string) (responses.SubResponse, error) {
+	if record.RequestHash != requestHash {
+		c.logger.Error().Msg("Idempotency key reused with different request")
+		return responses.SubResponse{}, errors.Wrap(ErrIdempotencyKeyReused, "request doesn't match idempotency key")
+	}
+
+	var sub entities.Subscription
+	if err := json.Unmarshal(record.Response, &sub); err != nil {
+		c.logger.Error().Err(err).Msg("Failed to unmarshal stored subscription")
+		return responses.SubResponse{}, errors.Wrap(err, "failed to unmarshal stored subscription")
+	}
+
+	return toSubResponse(ctx, sub), nil
+}
+
+func hashRequest(req requests.SubRequest) (string, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (c *createSubUseCase) createSubscription(ctx context.Context, req requests.SubRequest) (entities.Subscription, error) {
+	sub, err := newSubscription(req, c.logger)
+	if err != nil {
+		return entities.Subscription{}, err
+	}
+
+	if err := c.subRepo.Insert(ctx, &sub); err != nil {
+		c.logger.Error().Err(err).Msg("Failed to insert subscription")
+		return entities.Subscription{}, errors.Wrap(err, "failed to create subscription")
+	}
+
+	return sub, nil
+}
+
+// newSubscription validates req and builds the first version of a new subscription.
+func newSubscription(req requests.SubRequest, log logger.Logger) (entities.Subscription, error) {
+	if req.ServiceName == "" || req.Price == 0 {
+		log.Error().Msg("Missing service_name or price")
+		return entities.Subscription{}, errors.Wrap(ErrInvalidField, "service_name and price are required")
+	}
+	if req.Price < 0 {
+		log.Error().Msg("Negative price")
+		return entities.Subscription{}, errors.Wrap(ErrInvalidField, "price must be positive")
+	}
+	if utf8.RuneCountInString(req.ServiceName) > maxServiceNameLength {
+		log.Error().Msg("Service name is too long")
+		return entities.Subscription{}, errors.Wrapf(ErrInvalidField, "service_name must be at most %d characters", maxServiceNameLength)
+	}
+
 	userID, err := uuid.Parse(req.UserID)
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Invalid user_id format")
-		return responses.SubResponse{}, errors.Wrap(ErrInvalidUUID, "failed to parse user_id")
+		log.Error().Err(err).Msg("Invalid user_id format")
+		return entities.Subscription{}, errors.Wrap(ErrInvalidUUID, "failed to parse user_id")
+	}
+
+	startDate, err := parseStartDate(req.StartDate)
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid start_date format")
+		return entities.Subscription{}, errors.Wrap(err, "failed to parse start_date")
+	}
+
+	currency, err := parseCurrency(req.Currency)
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid currency")
+		return entities.Subscription{}, errors.Wrap(err, "failed to parse currency")
 	}
 
-	startDate, err := time.Parse("01-2006", req.StartDate)
+	billingPeriod, err := parseBillingPeriod(req.BillingPeriod)
 	if err != nil {
-		c.logger.Error().Err(err).Msg("Invalid start_date format")
-		return responses.SubResponse{}, errors.Wrap(ErrInvalidDateFormat, "failed to parse start_date")
+		log.Error().Err(err).Msg("Invalid billing period")
+		return entities.Subscription{}, errors.Wrap(err, "failed to parse billing_period")
 	}
 
 	var endDate *time.Time
 	if req.EndDate != "" {
-		ed, err := time.Parse("01-2006", req.EndDate)
+		ed, err := parseEndDate(req.EndDate)
 		if err != nil {
-			c.logger.Error().Err(err).Msg("Invalid end_date format")
-			return responses.SubResponse{}, errors.Wrap(ErrInvalidDateFormat, "failed to parse end_date")
+			log.Error().Err(err).Msg("Invalid end_date format")
+			return entities.Subscription{}, errors.Wrap(err, "failed to parse end_date")
 		}
 		endDate = &ed
 	}
 
-	sub := &entities.Subscription{
-		ID:          uuid.New(),
-		ServiceName: req.ServiceName,
-		Price:       req.Price,
-		UserID:      userID,
-		StartDate:   startDate,
-		EndDate:     endDate,
+	trialStart, trialEnd, err := parseTrial(startDate, req.TrialStart, req.TrialEnd)
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid trial")
+		return entities.Subscription{}, err
 	}
 
-	if err := c.subRepo.Insert(ctx, sub); err != nil {
-		c.logger.Error().Err(err).Msg("Failed to insert subscription")
-		return responses.SubResponse{}, errors.Wrap(err, "failed to create subscription")
+	sub := entities.Subscription{
+		ID:             uuid.New(),
+		ServiceName:    req.ServiceName,
+		Price:          req.Price,
+		Currency:       currency,
+		BillingPeriod:  billingPeriod,
+		UserID:         userID,
+		StartDate:      startDate,
+		EndDate:        endDate,
+		TrialStartDate: trialStart,
+		TrialEndDate:   trialEnd,
+		Version:        1,
 	}
 
-	response := responses.SubResponse{
-		ID:          sub.ID.String(),
-		ServiceName: sub.ServiceName,
-		Price:       sub.Price,
-		UserID:      sub.UserID.String(),
-		StartDate:   sub.StartDate.Format("01-2006"),
-	}
-	if sub.EndDate != nil {
-		endDateStr := sub.EndDate.Format("01-2006")
-		response.EndDate = endDateStr
+	if err := validateTrial(sub); err != nil {
+		log.Error().Err(err).Msg("Invalid trial")
+		return entities.Subscription{}, err
 	}
 
-	return response, nil
+	return sub, nil
 }
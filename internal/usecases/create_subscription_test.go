@@ -2,10 +2,13 @@ package usecases
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/pkg/errors"
+	"strings"
 	"subscription_service/internal/controllers/requests"
 	"subscription_service/internal/entities"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -13,12 +16,22 @@ import (
 )
 
 var (
-	mockCreateSubRepo *MockCreateSubRepository
+	mockCreateSubRepo   *MockCreateSubRepository
+	mockIdempotencyRepo *MockIdempotencyRepository
+	mockTxManager       *MockTxManager
 )
 
 func initCreateSubTestMocks(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockCreateSubRepo = NewMockCreateSubRepository(ctrl)
+	mockIdempotencyRepo = NewMockIdempotencyRepository(ctrl)
+	mockTxManager = NewMockTxManager(ctrl)
+	mockTxManager.EXPECT().WithinTx(gomock.Any(), gomock.Any()).DoAndReturn(runWithinTx).AnyTimes()
+}
+
+// runWithinTx stands in for a transaction by running the unit of work directly.
+func runWithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
 }
 
 func TestCreateSubscription_Success(t *testing.T) {
@@ -34,8 +47,8 @@ func TestCreateSubscription_Success(t *testing.T) {
 	sub := gomock.AssignableToTypeOf(&entities.Subscription{})
 	mockCreateSubRepo.EXPECT().Insert(ctx, sub).Return(nil)
 
-	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockLogger)
-	response, err := useCase.CreateSubscription(ctx, req)
+	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockIdempotencyRepo, mockTxManager, time.Hour, mockLogger)
+	response, err := useCase.CreateSubscription(ctx, req, "")
 
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
@@ -43,6 +56,78 @@ func TestCreateSubscription_Success(t *testing.T) {
 	assert.Equal(t, req.Price, response.Price)
 	assert.Equal(t, req.UserID, response.UserID)
 	assert.Equal(t, req.StartDate, response.StartDate)
+	assert.Equal(t, entities.DefaultCurrency, response.Currency)
+	assert.Equal(t, entities.BillingMonthly, response.BillingPeriod)
+	assert.Equal(t, 1, response.Version)
+}
+
+func TestCreateSubscription_Success_DayPrecision(t *testing.T) {
+	initCreateSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.SubRequest{
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		UserID:      uuid.New().String(),
+		StartDate:   "2025-07-20",
+		EndDate:     "12-2025",
+	}
+
+	mockCreateSubRepo.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, sub *entities.Subscription) error {
+			assert.Equal(t, "2025-07-20", sub.StartDate.Format("2006-01-02"))
+			// A month-format end date covers the whole month.
+			assert.Equal(t, "2025-12-31", sub.EndDate.Format("2006-01-02"))
+			return nil
+		})
+
+	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockIdempotencyRepo, mockTxManager, time.Hour, mockLogger)
+	_, err := useCase.CreateSubscription(ctx, req, "")
+
+	assert.NoError(t, err)
+}
+
+func TestCreateSubscription_Success_Trial(t *testing.T) {
+	initCreateSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.SubRequest{
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		UserID:      uuid.New().String(),
+		StartDate:   "2025-07-01",
+		TrialEnd:    "2025-07-14",
+	}
+
+	mockCreateSubRepo.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, sub *entities.Subscription) error {
+			// A trial without a start begins with the subscription.
+			assert.Equal(t, "2025-07-01", sub.TrialStartDate.Format("2006-01-02"))
+			assert.Equal(t, "2025-07-14", sub.TrialEndDate.Format("2006-01-02"))
+			return nil
+		})
+
+	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockIdempotencyRepo, mockTxManager, time.Hour, mockLogger)
+	_, err := useCase.CreateSubscription(ctx, req, "")
+
+	assert.NoError(t, err)
+}
+
+func TestCreateSubscription_Failure_TrialBeforeStart(t *testing.T) {
+	initCreateSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.SubRequest{
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		UserID:      uuid.New().String(),
+		StartDate:   "2025-07-01",
+		TrialStart:  "2025-06-20",
+		TrialEnd:    "2025-07-14",
+	}
+
+	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockIdempotencyRepo, mockTxManager, time.Hour, mockLogger)
+	_, err := useCase.CreateSubscription(ctx, req, "")
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
 }
 
 func TestCreateSubscription_Failure_InvalidUserID(t *testing.T) {
@@ -55,8 +140,8 @@ func TestCreateSubscription_Failure_InvalidUserID(t *testing.T) {
 		StartDate:   "07-2025",
 	}
 
-	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockLogger)
-	_, err := useCase.CreateSubscription(ctx, req)
+	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockIdempotencyRepo, mockTxManager, time.Hour, mockLogger)
+	_, err := useCase.CreateSubscription(ctx, req, "")
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, ErrInvalidUUID)
@@ -72,13 +157,83 @@ func TestCreateSubscription_Failure_InvalidStartDate(t *testing.T) {
 		StartDate:   "invalid-date",
 	}
 
-	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockLogger)
-	_, err := useCase.CreateSubscription(ctx, req)
+	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockIdempotencyRepo, mockTxManager, time.Hour, mockLogger)
+	_, err := useCase.CreateSubscription(ctx, req, "")
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, ErrInvalidDateFormat)
 }
 
+func TestCreateSubscription_Failure_InvalidCurrency(t *testing.T) {
+	initCreateSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.SubRequest{
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		Currency:    "usd",
+		UserID:      uuid.New().String(),
+		StartDate:   "07-2025",
+	}
+
+	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockIdempotencyRepo, mockTxManager, time.Hour, mockLogger)
+	_, err := useCase.CreateSubscription(ctx, req, "")
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidCurrency)
+}
+
+func TestCreateSubscription_Failure_NegativePrice(t *testing.T) {
+	initCreateSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.SubRequest{
+		ServiceName: "Yandex Plus",
+		Price:       -5,
+		UserID:      uuid.New().String(),
+		StartDate:   "07-2025",
+	}
+
+	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockIdempotencyRepo, mockTxManager, time.Hour, mockLogger)
+	_, err := useCase.CreateSubscription(ctx, req, "")
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidField)
+}
+
+func TestCreateSubscription_Failure_ServiceNameTooLong(t *testing.T) {
+	initCreateSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.SubRequest{
+		ServiceName: strings.Repeat("я", maxServiceNameLength+1),
+		Price:       400,
+		UserID:      uuid.New().String(),
+		StartDate:   "07-2025",
+	}
+
+	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockIdempotencyRepo, mockTxManager, time.Hour, mockLogger)
+	_, err := useCase.CreateSubscription(ctx, req, "")
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidField)
+}
+
+func TestCreateSubscription_Failure_InvalidBillingPeriod(t *testing.T) {
+	initCreateSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.SubRequest{
+		ServiceName:   "Yandex Plus",
+		Price:         400,
+		BillingPeriod: "daily",
+		UserID:        uuid.New().String(),
+		StartDate:     "07-2025",
+	}
+
+	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockIdempotencyRepo, mockTxManager, time.Hour, mockLogger)
+	_, err := useCase.CreateSubscription(ctx, req, "")
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidBillingPeriod)
+}
+
 func TestCreateSubscription_Failure_InsertError(t *testing.T) {
 	initCreateSubTestMocks(t)
 	ctx := context.Background()
@@ -92,9 +247,170 @@ func TestCreateSubscription_Failure_InsertError(t *testing.T) {
 	expectedErr := errors.New("database error")
 	mockCreateSubRepo.EXPECT().Insert(ctx, gomock.Any()).Return(expectedErr)
 
-	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockLogger)
-	_, err := useCase.CreateSubscription(ctx, req)
+	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockIdempotencyRepo, mockTxManager, time.Hour, mockLogger)
+	_, err := useCase.CreateSubscription(ctx, req, "")
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, expectedErr)
+}
+
+func TestCreateSubscription_Success_StoresIdempotencyKey(t *testing.T) {
+	initCreateSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.SubRequest{
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		UserID:      uuid.New().String(),
+		StartDate:   "07-2025",
+	}
+
+	mockIdempotencyRepo.EXPECT().SelectByKey(ctx, "key-1").Return(entities.IdempotencyRecord{}, ErrEntityNotFound)
+	mockCreateSubRepo.EXPECT().Insert(ctx, gomock.Any()).Return(nil)
+	mockIdempotencyRepo.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, record entities.IdempotencyRecord) error {
+			assert.Equal(t, "key-1", record.Key)
+			assert.Len(t, record.RequestHash, 64)
+			assert.NotEmpty(t, record.Response)
+			assert.True(t, record.ExpiresAt.After(time.Now()))
+			return nil
+		})
+
+	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockIdempotencyRepo, mockTxManager, time.Hour, mockLogger)
+	response, err := useCase.CreateSubscription(ctx, req, "key-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, req.ServiceName, response.ServiceName)
+	assert.Equal(t, 1, response.Version)
+}
+
+func TestCreateSubscription_Success_ReplaysStoredResponse(t *testing.T) {
+	initCreateSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.SubRequest{
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		UserID:      uuid.New().String(),
+		StartDate:   "07-2025",
+	}
+
+	var stored entities.IdempotencyRecord
+	mockIdempotencyRepo.EXPECT().SelectByKey(ctx, "key-1").Return(entities.IdempotencyRecord{}, ErrEntityNotFound)
+	mockCreateSubRepo.EXPECT().Insert(ctx, gomock.Any()).Return(nil).Times(1)
+	mockIdempotencyRepo.EXPECT().Insert(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, record entities.IdempotencyRecord) error {
+			stored = record
+			return nil
+		})
+
+	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockIdempotencyRepo, mockTxManager, time.Hour, mockLogger)
+	first, err := useCase.CreateSubscription(ctx, req, "key-1")
+	assert.NoError(t, err)
+
+	mockIdempotencyRepo.EXPECT().SelectByKey(ctx, "key-1").Return(stored, nil)
+	second, err := useCase.CreateSubscription(ctx, req, "key-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, first, second)
+}
+
+func TestCreateSubscription_Failure_IdempotencyKeyReused(t *testing.T) {
+	initCreateSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.SubRequest{
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		UserID:      uuid.New().String(),
+		StartDate:   "07-2025",
+	}
+
+	record := entities.IdempotencyRecord{
+		Key:         "key-1",
+		RequestHash: "another-request-hash",
+		Response:    []byte(`{}`),
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+	mockIdempotencyRepo.EXPECT().SelectByKey(ctx, "key-1").Return(record, nil)
+
+	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockIdempotencyRepo, mockTxManager, time.Hour, mockLogger)
+	_, err := useCase.CreateSubscription(ctx, req, "key-1")
 
 	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+}
+
+func TestCreateSubscription_Failure_IdempotencyKeyNotSaved(t *testing.T) {
+	initCreateSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.SubRequest{
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		UserID:      uuid.New().String(),
+		StartDate:   "07-2025",
+	}
+
+	expectedErr := errors.New("database error")
+	mockIdempotencyRepo.EXPECT().SelectByKey(ctx, "key-1").Return(entities.IdempotencyRecord{}, ErrEntityNotFound)
+	mockCreateSubRepo.EXPECT().Insert(ctx, gomock.Any()).Return(nil)
+	mockIdempotencyRepo.EXPECT().Insert(ctx, gomock.Any()).Return(expectedErr)
+
+	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockIdempotencyRepo, mockTxManager, time.Hour, mockLogger)
+	_, err := useCase.CreateSubscription(ctx, req, "key-1")
+
 	assert.ErrorIs(t, err, expectedErr)
 }
+
+func TestCreateSubscription_Success_ReplaysConcurrentRequest(t *testing.T) {
+	initCreateSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.SubRequest{
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		UserID:      uuid.New().String(),
+		StartDate:   "07-2025",
+	}
+	requestHash, _ := hashRequest(req)
+	winner := entities.Subscription{ID: uuid.New(), ServiceName: req.ServiceName, Price: req.Price, Version: 1}
+	stored, _ := json.Marshal(winner)
+
+	mockIdempotencyRepo.EXPECT().SelectByKey(ctx, "key-1").Return(entities.IdempotencyRecord{}, ErrEntityNotFound)
+	mockCreateSubRepo.EXPECT().Insert(ctx, gomock.Any()).Return(nil)
+	mockIdempotencyRepo.EXPECT().Insert(ctx, gomock.Any()).Return(ErrIdempotencyKeyInUse)
+	mockIdempotencyRepo.EXPECT().SelectByKey(ctx, "key-1").Return(entities.IdempotencyRecord{
+		Key:         "key-1",
+		RequestHash: requestHash,
+		Response:    stored,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}, nil)
+
+	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockIdempotencyRepo, mockTxManager, time.Hour, mockLogger)
+	response, err := useCase.CreateSubscription(ctx, req, "key-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, winner.ID.String(), response.ID)
+}
+
+func TestCreateSubscription_Failure_ConcurrentRequestWithDifferentBody(t *testing.T) {
+	initCreateSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.SubRequest{
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		UserID:      uuid.New().String(),
+		StartDate:   "07-2025",
+	}
+
+	mockIdempotencyRepo.EXPECT().SelectByKey(ctx, "key-1").Return(entities.IdempotencyRecord{}, ErrEntityNotFound)
+	mockCreateSubRepo.EXPECT().Insert(ctx, gomock.Any()).Return(nil)
+	mockIdempotencyRepo.EXPECT().Insert(ctx, gomock.Any()).Return(ErrIdempotencyKeyInUse)
+	mockIdempotencyRepo.EXPECT().SelectByKey(ctx, "key-1").Return(entities.IdempotencyRecord{
+		Key:         "key-1",
+		RequestHash: "another-request-hash",
+		Response:    []byte(`{}`),
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}, nil)
+
+	useCase := NewCreateSubUseCase(mockCreateSubRepo, mockIdempotencyRepo, mockTxManager, time.Hour, mockLogger)
+	_, err := useCase.CreateSubscription(ctx, req, "key-1")
+
+	assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+}
@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	"fmt"
+	"math"
+	"subscription_service/internal/entities"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+)
+
+var currencyValidator = validator.New()
+
+// parseCurrency validates an ISO 4217 code, falling back to the default currency when it's empty.
+func parseCurrency(code string) (string, error) {
+	if code == "" {
+		return entities.DefaultCurrency, nil
+	}
+	if err := currencyValidator.Var(code, "iso4217"); err != nil {
+		return "", errors.Wrapf(ErrInvalidCurrency, "unknown currency %q", code)
+	}
+
+	return code, nil
+}
+
+type currencyPair struct {
+	base, quote string
+}
+
+// currencyConverter converts amounts into a target currency. Pairs that aren't
+// loaded directly are derived from the inverse rate or crossed through the default currency.
+type currencyConverter struct {
+	target string
+	rates  map[currencyPair]float64
+}
+
+func newCurrencyConverter(target string, rates []entities.ExchangeRate) *currencyConverter {
+	converter := &currencyConverter{
+		target: target,
+		rates:  make(map[currencyPair]float64, len(rates)),
+	}
+	for _, rate := range rates {
+		converter.rates[currencyPair{rate.Base, rate.Quote}] = rate.Rate
+	}
+
+	return converter
+}
+
+func (c *currencyConverter) convert(amount int, from string) (int, error) {
+	if from == c.target {
+		return amount, nil
+	}
+
+	rate, ok := c.rate(from, c.target)
+	if !ok {
+		rate, ok = c.crossRate(from, c.target)
+	}
+	if !ok {
+		return 0, errors.Wrap(ErrExchangeRateNotFound, fmt.Sprintf("no rate for %s/%s", from, c.target))
+	}
+
+	return int(math.Round(float64(amount) * rate)), nil
+}
+
+func (c *currencyConverter) rate(base, quote string) (float64, bool) {
+	if rate, ok := c.rates[currencyPair{base, quote}]; ok {
+		return rate, true
+	}
+	if rate, ok := c.rates[currencyPair{quote, base}]; ok {
+		return 1 / rate, true
+	}
+
+	return 0, false
+}
+
+func (c *currencyConverter) crossRate(base, quote string) (float64, bool) {
+	toDefault, ok := c.rate(base, entities.DefaultCurrency)
+	if !ok {
+		return 0, false
+	}
+	fromDefault, ok := c.rate(entities.DefaultCurrency, quote)
+	if !ok {
+		return 0, false
+	}
+
+	return toDefault * fromDefault, true
+}
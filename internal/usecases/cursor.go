@@ -0,0 +1,75 @@
+package usecases
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"strconv"
+	"subscription_service/internal/entities"
+)
+
+// listCursor is the opaque pagination token handed out to clients. It pins
+// the sort it was issued for so it can't be replayed against another order.
+type listCursor struct {
+	Sort  string `json:"s"`
+	Desc  bool   `json:"d,omitempty"`
+	Value string `json:"v"`
+	ID    string `json:"id"`
+}
+
+func encodeCursor(sort entities.SubscriptionSort, sub entities.Subscription) string {
+	data, _ := json.Marshal(listCursor{
+		Sort:  sort.Field,
+		Desc:  sort.Desc,
+		Value: sortValue(sub, sort.Field),
+		ID:    sub.ID.String(),
+	})
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(token string, sort entities.SubscriptionSort) (*entities.Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidCursor, "failed to decode cursor")
+	}
+
+	var cursor listCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, errors.Wrap(ErrInvalidCursor, "failed to unmarshal cursor")
+	}
+
+	if cursor.Sort != sort.Field || cursor.Desc != sort.Desc {
+		return nil, errors.Wrap(ErrInvalidCursor, "cursor was issued for another sort order")
+	}
+
+	id, err := uuid.Parse(cursor.ID)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidCursor, "failed to parse cursor id")
+	}
+
+	return &entities.Cursor{Value: cursor.Value, ID: id}, nil
+}
+
+// sortValue renders the sort field of a subscription the way the repository
+// compares it in keyset pagination.
+func sortValue(sub entities.Subscription, field string) string {
+	switch field {
+	case "service_name":
+		return sub.ServiceName
+	case "price":
+		return strconv.Itoa(currentPrice(sub))
+	case "user_id":
+		return sub.UserID.String()
+	case "start_date":
+		return sub.StartDate.Format(dayLayout)
+	case "end_date":
+		if sub.EndDate == nil {
+			return "infinity"
+		}
+		return sub.EndDate.Format(dayLayout)
+	default:
+		return sub.ID.String()
+	}
+}
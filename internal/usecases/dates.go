@@ -0,0 +1,69 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	monthLayout = "01-2006"
+	dayLayout   = "2006-01-02"
+)
+
+const (
+	// DateFormatMonth renders dates as MM-YYYY, the format of earlier API versions.
+	DateFormatMonth = "month"
+	// DateFormatISO renders dates as YYYY-MM-DD.
+	DateFormatISO = "iso"
+)
+
+type dateFormatKey struct{}
+
+// WithDateFormat returns a context that makes responses render dates in the given format.
+func WithDateFormat(ctx context.Context, format string) context.Context {
+	return context.WithValue(ctx, dateFormatKey{}, format)
+}
+
+// today returns the current UTC date, the day dates without an explicit value default to.
+func today() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
+func formatDate(ctx context.Context, t time.Time) string {
+	if format, _ := ctx.Value(dateFormatKey{}).(string); format == DateFormatISO {
+		return t.Format(dayLayout)
+	}
+
+	return t.Format(monthLayout)
+}
+
+// parseStartDate accepts YYYY-MM-DD or MM-YYYY; a month means its first day.
+func parseStartDate(value string) (time.Time, error) {
+	if t, err := time.Parse(dayLayout, value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse(monthLayout, value)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(ErrInvalidDateFormat, "expected YYYY-MM-DD or MM-YYYY, got %q", value)
+	}
+
+	return t, nil
+}
+
+// parseEndDate accepts YYYY-MM-DD or MM-YYYY; a month means its last day,
+// so that the whole month stays included.
+func parseEndDate(value string) (time.Time, error) {
+	if t, err := time.Parse(dayLayout, value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse(monthLayout, value)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(ErrInvalidDateFormat, "expected YYYY-MM-DD or MM-YYYY, got %q", value)
+	}
+
+	return t.AddDate(0, 1, -1), nil
+}
@@ -14,7 +14,7 @@ type deleteSubUseCase struct {
 }
 
 type DeleteSubUseCase interface {
-	DeleteSubscription(ctx context.Context, subID string) error
+	DeleteSubscription(ctx context.Context, subID string, version int) error
 }
 
 func NewDeleteSubUseCase(subRepo DeleteSubRepository, logger logger.Logger) DeleteSubUseCase {
@@ -24,13 +24,13 @@ func NewDeleteSubUseCase(subRepo DeleteSubRepository, logger logger.Logger) Dele
 	}
 }
 
-func (d *deleteSubUseCase) DeleteSubscription(ctx context.Context, subID string) error {
+func (d *deleteSubUseCase) DeleteSubscription(ctx context.Context, subID string, version int) error {
 	if _, err := uuid.Parse(subID); err != nil {
 		d.logger.Error().Err(err).Msg("Invalid sub_id format")
 		return errors.Wrap(ErrInvalidUUID, "failed to parse sub_id")
 	}
 
-	if err := d.subRepo.Delete(ctx, subID); err != nil {
+	if err := d.subRepo.Delete(ctx, subID, version); err != nil {
 		d.logger.Error().Err(err).Msg("Failed to delete subscription")
 		return errors.Wrap(err, "failed to delete subscription")
 	}
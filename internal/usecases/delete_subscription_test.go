@@ -24,10 +24,10 @@ func TestDeleteSubscription_Success(t *testing.T) {
 	ctx := context.Background()
 	subID := uuid.New().String()
 
-	mockDeleteSubRepo.EXPECT().Delete(ctx, subID).Return(nil)
+	mockDeleteSubRepo.EXPECT().Delete(ctx, subID, 1).Return(nil)
 
 	useCase := NewDeleteSubUseCase(mockDeleteSubRepo, mockLogger)
-	err := useCase.DeleteSubscription(ctx, subID)
+	err := useCase.DeleteSubscription(ctx, subID, 1)
 
 	assert.NoError(t, err)
 }
@@ -38,7 +38,7 @@ func TestDeleteSubscription_Failure_InvalidSubID(t *testing.T) {
 	subID := "invalid-uuid"
 
 	useCase := NewDeleteSubUseCase(mockDeleteSubRepo, mockLogger)
-	err := useCase.DeleteSubscription(ctx, subID)
+	err := useCase.DeleteSubscription(ctx, subID, 1)
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, ErrInvalidUUID)
@@ -49,25 +49,39 @@ func TestDeleteSubscription_Failure_NotFound(t *testing.T) {
 	ctx := context.Background()
 	subID := uuid.New().String()
 
-	mockDeleteSubRepo.EXPECT().Delete(ctx, subID).Return(ErrEntityNotFound)
+	mockDeleteSubRepo.EXPECT().Delete(ctx, subID, 1).Return(ErrEntityNotFound)
 
 	useCase := NewDeleteSubUseCase(mockDeleteSubRepo, mockLogger)
-	err := useCase.DeleteSubscription(ctx, subID)
+	err := useCase.DeleteSubscription(ctx, subID, 1)
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, ErrEntityNotFound)
 }
 
+func TestDeleteSubscription_Failure_VersionMismatch(t *testing.T) {
+	initDeleteSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+
+	mockDeleteSubRepo.EXPECT().Delete(ctx, subID, 1).Return(ErrVersionMismatch)
+
+	useCase := NewDeleteSubUseCase(mockDeleteSubRepo, mockLogger)
+	err := useCase.DeleteSubscription(ctx, subID, 1)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrVersionMismatch)
+}
+
 func TestDeleteSubscription_Failure_DatabaseError(t *testing.T) {
 	initDeleteSubTestMocks(t)
 	ctx := context.Background()
 	subID := uuid.New().String()
 
 	expectedErr := errors.New("database error")
-	mockDeleteSubRepo.EXPECT().Delete(ctx, subID).Return(expectedErr)
+	mockDeleteSubRepo.EXPECT().Delete(ctx, subID, 1).Return(expectedErr)
 
 	useCase := NewDeleteSubUseCase(mockDeleteSubRepo, mockLogger)
-	err := useCase.DeleteSubscription(ctx, subID)
+	err := useCase.DeleteSubscription(ctx, subID, 1)
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, expectedErr)
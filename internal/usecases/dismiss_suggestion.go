@@ -0,0 +1,44 @@
+package usecases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+)
+
+type dismissSuggestionUseCase struct {
+	suggestionRepo SuggestionStatusRepository
+	logger         logger.Logger
+}
+
+type DismissSuggestionUseCase interface {
+	DismissSuggestion(ctx context.Context, userID, suggestionID string) error
+}
+
+func NewDismissSuggestionUseCase(suggestionRepo SuggestionStatusRepository, logger logger.Logger) DismissSuggestionUseCase {
+	return &dismissSuggestionUseCase{
+		suggestionRepo: suggestionRepo,
+		logger:         logger,
+	}
+}
+
+// DismissSuggestion hides a suggestion for good: later statements with the
+// same recurring debit don't bring it back.
+func (d *dismissSuggestionUseCase) DismissSuggestion(ctx context.Context, userID, suggestionID string) error {
+	uid, sid, err := parseSuggestionIDs(userID, suggestionID, d.logger)
+	if err != nil {
+		return err
+	}
+
+	if _, err := pendingSuggestion(ctx, d.suggestionRepo, uid, sid, d.logger); err != nil {
+		return err
+	}
+
+	if err := d.suggestionRepo.SetStatus(ctx, sid, entities.SuggestionDismissed, nil); err != nil {
+		d.logger.Error().Err(err).Msg("Failed to dismiss subscription suggestion")
+		return errors.Wrap(err, "failed to dismiss subscription suggestion")
+	}
+
+	return nil
+}
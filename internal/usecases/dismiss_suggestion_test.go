@@ -0,0 +1,66 @@
+package usecases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/internal/entities"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDismissSuggestion_Success(t *testing.T) {
+	initAcceptSuggestionTestMocks(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	suggestion := testSuggestion(userID)
+
+	mockSuggestionStatusRepo.EXPECT().SelectByID(ctx, userID, suggestion.ID).Return(suggestion, nil)
+	mockSuggestionStatusRepo.EXPECT().SetStatus(ctx, suggestion.ID, entities.SuggestionDismissed, nil).Return(nil)
+
+	useCase := NewDismissSuggestionUseCase(mockSuggestionStatusRepo, mockLogger)
+	err := useCase.DismissSuggestion(ctx, userID.String(), suggestion.ID.String())
+
+	assert.NoError(t, err)
+}
+
+func TestDismissSuggestion_Failure_AlreadyAccepted(t *testing.T) {
+	initAcceptSuggestionTestMocks(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	suggestion := testSuggestion(userID)
+	suggestion.Status = entities.SuggestionAccepted
+
+	mockSuggestionStatusRepo.EXPECT().SelectByID(ctx, userID, suggestion.ID).Return(suggestion, nil)
+
+	useCase := NewDismissSuggestionUseCase(mockSuggestionStatusRepo, mockLogger)
+	err := useCase.DismissSuggestion(ctx, userID.String(), suggestion.ID.String())
+
+	assert.ErrorIs(t, err, ErrInvalidStatus)
+}
+
+func TestDismissSuggestion_Failure_InvalidUserID(t *testing.T) {
+	initAcceptSuggestionTestMocks(t)
+
+	useCase := NewDismissSuggestionUseCase(mockSuggestionStatusRepo, mockLogger)
+	err := useCase.DismissSuggestion(context.Background(), "invalid-uuid", uuid.New().String())
+
+	assert.ErrorIs(t, err, ErrInvalidUUID)
+}
+
+func TestDismissSuggestion_Failure_RepositoryError(t *testing.T) {
+	initAcceptSuggestionTestMocks(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	suggestion := testSuggestion(userID)
+	dbErr := errors.New("database error")
+
+	mockSuggestionStatusRepo.EXPECT().SelectByID(ctx, userID, suggestion.ID).Return(suggestion, nil)
+	mockSuggestionStatusRepo.EXPECT().SetStatus(ctx, suggestion.ID, entities.SuggestionDismissed, nil).Return(dbErr)
+
+	useCase := NewDismissSuggestionUseCase(mockSuggestionStatusRepo, mockLogger)
+	err := useCase.DismissSuggestion(ctx, userID.String(), suggestion.ID.String())
+
+	assert.ErrorIs(t, err, dbErr)
+}
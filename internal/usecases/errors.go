@@ -6,3 +6,18 @@ var ErrEntityNotFound = errors.New("entity not found")
 var ErrEntityAlreadyExists = errors.New("entity already exists")
 var ErrInvalidDateFormat = errors.New("invalid date format")
 var ErrInvalidUUID = errors.New("invalid UUID format")
+var ErrInvalidPeriod = errors.New("invalid period")
+var ErrInvalidGroupBy = errors.New("invalid group_by value")
+var ErrInvalidFilter = errors.New("invalid filter")
+var ErrInvalidCursor = errors.New("invalid cursor")
+var ErrInvalidField = errors.New("invalid field value")
+var ErrVersionMismatch = errors.New("version mismatch")
+var ErrIdempotencyKeyReused = errors.New("idempotency key reused with different request")
+var ErrIdempotencyKeyInUse = errors.New("idempotency key is in use by a concurrent request")
+var ErrInvalidCurrency = errors.New("invalid currency code")
+var ErrExchangeRateNotFound = errors.New("exchange rate not found")
+var ErrInvalidBillingPeriod = errors.New("invalid billing period")
+var ErrInvalidCostMode = errors.New("invalid cost mode")
+var ErrInvalidProration = errors.New("invalid proration")
+var ErrInvalidStatus = errors.New("operation is not allowed in the current status")
+var ErrBatchAborted = errors.New("batch item not applied because another item failed")
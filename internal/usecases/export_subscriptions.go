@@ -0,0 +1,168 @@
+package usecases
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ExportFormatCSV   = "csv"
+	ExportFormatJSONL = "jsonl"
+)
+
+// exportFlushRows is how many rows are buffered before they are handed to
+// the client.
+const exportFlushRows = 1000
+
+// exportColumns is the CSV header; JSON Lines use the SubResponse fields.
+var exportColumns = []string{
+	"id",
+	"service_name",
+	"price",
+	"currency",
+	"billing_period",
+	"user_id",
+	"start_date",
+	"end_date",
+	"trial_start_date",
+	"trial_end_date",
+	"status",
+	"cancelled_at",
+	"cancel_reason",
+}
+
+type exportSubsUseCase struct {
+	subRepo ExportSubsRepository
+	logger  logger.Logger
+}
+
+type ExportSubsUseCase interface {
+	ExportSubscriptions(ctx context.Context, req requests.ExportSubscriptions, w io.Writer) error
+}
+
+func NewExportSubsUseCase(subRepo ExportSubsRepository, logger logger.Logger) ExportSubsUseCase {
+	return &exportSubsUseCase{
+		subRepo: subRepo,
+		logger:  logger,
+	}
+}
+
+// ExportSubscriptions writes every subscription matching the list filters to
+// w as CSV or JSON Lines while reading them from the database. Nothing is
+// written to w until the request has been validated.
+func (e *exportSubsUseCase) ExportSubscriptions(ctx context.Context, req requests.ExportSubscriptions, w io.Writer) error {
+	filter, err := parseSubFilter(req.SubscriptionFilter, e.logger)
+	if err != nil {
+		return err
+	}
+
+	sort := entities.SubscriptionSort{
+		Field: req.Sort,
+		Desc:  req.Order == "desc",
+	}
+
+	var encoder exportEncoder
+	switch req.Format {
+	case ExportFormatCSV:
+		encoder = &csvExportEncoder{writer: csv.NewWriter(w)}
+	case ExportFormatJSONL:
+		buf := bufio.NewWriter(w)
+		encoder = &jsonlExportEncoder{buf: buf, encoder: json.NewEncoder(buf)}
+	default:
+		e.logger.Error().Msgf("Unknown export format %q", req.Format)
+		return errors.Wrapf(ErrInvalidFilter, "unknown export format %q", req.Format)
+	}
+
+	if err := encoder.Begin(); err != nil {
+		e.logger.Error().Err(err).Msg("Failed to write export header")
+		return errors.Wrap(err, "failed to write export")
+	}
+
+	rows := 0
+	err = e.subRepo.Stream(ctx, filter, sort, func(sub entities.Subscription) error {
+		if err := encoder.Encode(toSubResponse(ctx, sub)); err != nil {
+			return errors.Wrap(err, "failed to write export")
+		}
+		rows++
+		if rows%exportFlushRows == 0 {
+			return encoder.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		e.logger.Error().Err(err).Msgf("Failed to export subscriptions after %d rows", rows)
+		return errors.Wrap(err, "failed to export subscriptions")
+	}
+
+	if err := encoder.Flush(); err != nil {
+		e.logger.Error().Err(err).Msg("Failed to flush export")
+		return errors.Wrap(err, "failed to write export")
+	}
+
+	return nil
+}
+
+type exportEncoder interface {
+	Begin() error
+	Encode(sub responses.SubResponse) error
+	Flush() error
+}
+
+type csvExportEncoder struct {
+	writer *csv.Writer
+}
+
+func (c *csvExportEncoder) Begin() error {
+	return c.writer.Write(exportColumns)
+}
+
+func (c *csvExportEncoder) Encode(sub responses.SubResponse) error {
+	return c.writer.Write([]string{
+		sub.ID,
+		sub.ServiceName,
+		strconv.Itoa(sub.Price),
+		sub.Currency,
+		sub.BillingPeriod,
+		sub.UserID,
+		sub.StartDate,
+		sub.EndDate,
+		sub.TrialStart,
+		sub.TrialEnd,
+		sub.Status,
+		sub.CancelledAt,
+		sub.CancelReason,
+	})
+}
+
+func (c *csvExportEncoder) Flush() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+type jsonlExportEncoder struct {
+	buf     *bufio.Writer
+	encoder *json.Encoder
+}
+
+func (j *jsonlExportEncoder) Begin() error {
+	return nil
+}
+
+// Encode writes one JSON object followed by a newline.
+func (j *jsonlExportEncoder) Encode(sub responses.SubResponse) error {
+	return j.encoder.Encode(sub)
+}
+
+func (j *jsonlExportEncoder) Flush() error {
+	return j.buf.Flush()
+}
@@ -0,0 +1,156 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"strings"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var mockExportSubsRepo *MockExportSubsRepository
+
+func initExportSubsTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockExportSubsRepo = NewMockExportSubsRepository(ctrl)
+	mockLogger = logger.NewMockLogger(t)
+}
+
+func exportTestSubs() []entities.Subscription {
+	endDate := time.Date(2099, 12, 31, 0, 0, 0, 0, time.UTC)
+	return []entities.Subscription{
+		{
+			ID:            uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+			ServiceName:   "Yandex Plus",
+			Price:         400,
+			Currency:      "RUB",
+			BillingPeriod: entities.BillingMonthly,
+			UserID:        uuid.MustParse("6060ffee-2bf1-4721-ae6f-7636e979a0cb"),
+			StartDate:     time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:       &endDate,
+		},
+		{
+			ID:            uuid.MustParse("22222222-2222-2222-2222-222222222222"),
+			ServiceName:   "Okko, Premium",
+			Price:         300,
+			Currency:      "RUB",
+			BillingPeriod: entities.BillingMonthly,
+			UserID:        uuid.MustParse("6060ffee-2bf1-4721-ae6f-7636e979a0cb"),
+			StartDate:     time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func streamSubs(subs []entities.Subscription) func(context.Context, entities.SubscriptionFilter, entities.SubscriptionSort, func(entities.Subscription) error) error {
+	return func(_ context.Context, _ entities.SubscriptionFilter, _ entities.SubscriptionSort, fn func(entities.Subscription) error) error {
+		for _, sub := range subs {
+			if err := fn(sub); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func TestExportSubscriptions_Success_CSV(t *testing.T) {
+	initExportSubsTestMocks(t)
+	ctx := context.Background()
+	req := requests.ExportSubscriptions{
+		SubscriptionFilter: requests.SubscriptionFilter{UserID: "6060ffee-2bf1-4721-ae6f-7636e979a0cb"},
+		Format:             ExportFormatCSV,
+		Sort:               "price",
+		Order:              "desc",
+	}
+
+	mockExportSubsRepo.EXPECT().Stream(ctx, gomock.Any(), entities.SubscriptionSort{Field: "price", Desc: true}, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, filter entities.SubscriptionFilter, sort entities.SubscriptionSort, fn func(entities.Subscription) error) error {
+			assert.Equal(t, "6060ffee-2bf1-4721-ae6f-7636e979a0cb", filter.UserID.String())
+			return streamSubs(exportTestSubs())(ctx, filter, sort, fn)
+		})
+
+	var buf bytes.Buffer
+	useCase := NewExportSubsUseCase(mockExportSubsRepo, mockLogger)
+	err := useCase.ExportSubscriptions(ctx, req, &buf)
+
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	assert.Len(t, lines, 3)
+	assert.Equal(t, strings.Join(exportColumns, ","), lines[0])
+	assert.Equal(t, "11111111-1111-1111-1111-111111111111,Yandex Plus,400,RUB,monthly,6060ffee-2bf1-4721-ae6f-7636e979a0cb,07-2025,12-2099,,,active,,", lines[1])
+	assert.True(t, strings.HasPrefix(lines[2], `22222222-2222-2222-2222-222222222222,"Okko, Premium",300,`))
+}
+
+func TestExportSubscriptions_Success_JSONL(t *testing.T) {
+	initExportSubsTestMocks(t)
+	ctx := context.Background()
+	req := requests.ExportSubscriptions{Format: ExportFormatJSONL, Sort: "id", Order: "asc"}
+
+	mockExportSubsRepo.EXPECT().Stream(ctx, entities.SubscriptionFilter{}, gomock.Any(), gomock.Any()).
+		DoAndReturn(streamSubs(exportTestSubs()))
+
+	var buf bytes.Buffer
+	useCase := NewExportSubsUseCase(mockExportSubsRepo, mockLogger)
+	err := useCase.ExportSubscriptions(ctx, req, &buf)
+
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	assert.Len(t, lines, 2)
+	var row map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &row))
+	assert.Equal(t, "Okko, Premium", row["service_name"])
+	assert.Equal(t, float64(300), row["price"])
+	assert.NotContains(t, row, "end_date")
+}
+
+func TestExportSubscriptions_Success_Empty(t *testing.T) {
+	initExportSubsTestMocks(t)
+	ctx := context.Background()
+
+	mockExportSubsRepo.EXPECT().Stream(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	var buf bytes.Buffer
+	useCase := NewExportSubsUseCase(mockExportSubsRepo, mockLogger)
+	err := useCase.ExportSubscriptions(ctx, requests.ExportSubscriptions{Format: ExportFormatCSV, Sort: "id"}, &buf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Join(exportColumns, ",")+"\n", buf.String())
+}
+
+func TestExportSubscriptions_Failure_InvalidFilter(t *testing.T) {
+	initExportSubsTestMocks(t)
+	req := requests.ExportSubscriptions{
+		SubscriptionFilter: requests.SubscriptionFilter{UserID: "not-a-uuid"},
+		Format:             ExportFormatCSV,
+		Sort:               "id",
+	}
+
+	var buf bytes.Buffer
+	useCase := NewExportSubsUseCase(mockExportSubsRepo, mockLogger)
+	err := useCase.ExportSubscriptions(context.Background(), req, &buf)
+
+	assert.ErrorIs(t, err, ErrInvalidUUID)
+	assert.Zero(t, buf.Len())
+}
+
+func TestExportSubscriptions_Failure_RepositoryError(t *testing.T) {
+	initExportSubsTestMocks(t)
+	ctx := context.Background()
+
+	mockExportSubsRepo.EXPECT().Stream(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+
+	var buf bytes.Buffer
+	useCase := NewExportSubsUseCase(mockExportSubsRepo, mockLogger)
+	err := useCase.ExportSubscriptions(ctx, requests.ExportSubscriptions{Format: ExportFormatJSONL, Sort: "id"}, &buf)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "database error")
+}
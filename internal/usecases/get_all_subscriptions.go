@@ -2,14 +2,19 @@ package usecases
 
 import (
 	"context"
+	"slices"
+	"strings"
+	"subscription_service/internal/controllers/requests"
 	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"subscription_service/pkg/logger"
 )
 
 type GetListSubUseCase interface {
-	GetListSubscriptions(ctx context.Context, limit, offset int) ([]responses.SubResponse, error)
+	GetListSubscriptions(ctx context.Context, req requests.GetListSubscriptions) (responses.SubList, error)
 }
 
 type getListSubUseCase struct {
@@ -24,28 +29,112 @@ func NewGetListSubUseCase(subRepo GetAllSubsRepository, logger logger.Logger) Ge
 	}
 }
 
-func (g *getListSubUseCase) GetListSubscriptions(ctx context.Context, limit, offset int) ([]responses.SubResponse, error) {
-	subs, err := g.subRepo.SelectAll(ctx, limit, offset)
+func (g *getListSubUseCase) GetListSubscriptions(ctx context.Context, req requests.GetListSubscriptions) (responses.SubList, error) {
+	filter, err := parseSubFilter(req.SubscriptionFilter, g.logger)
+	if err != nil {
+		return responses.SubList{}, err
+	}
+
+	sort := entities.SubscriptionSort{
+		Field: req.Sort,
+		Desc:  req.Order == "desc",
+	}
+
+	page := entities.Page{Limit: req.Limit, Offset: req.Offset}
+	if req.Cursor != nil {
+		// one extra row tells whether there is a next page
+		page.Limit++
+		if *req.Cursor != "" {
+			page.After, err = decodeCursor(*req.Cursor, sort)
+			if err != nil {
+				g.logger.Error().Err(err).Msg("Invalid cursor")
+				return responses.SubList{}, err
+			}
+		}
+	}
+
+	subs, err := g.subRepo.SelectAll(ctx, filter, sort, page)
 	if err != nil {
 		g.logger.Error().Err(err).Msg("Failed to get subscriptions")
-		return nil, errors.Wrap(err, "failed to get subscriptions")
+		return responses.SubList{}, errors.Wrap(err, "failed to get subscriptions")
 	}
 
-	response := make([]responses.SubResponse, 0, len(subs))
-	for _, sub := range subs {
-		resp := responses.SubResponse{
-			ID:          sub.ID.String(),
-			ServiceName: sub.ServiceName,
-			Price:       sub.Price,
-			UserID:      sub.UserID.String(),
-			StartDate:   sub.StartDate.Format("01-2006"),
+	response := responses.SubList{Limit: req.Limit}
+	if req.Cursor != nil {
+		response.Cursor = req.Cursor
+		if len(subs) > req.Limit {
+			subs = subs[:req.Limit]
+			response.NextCursor = encodeCursor(sort, subs[len(subs)-1])
 		}
-		if sub.EndDate != nil {
-			endDateStr := sub.EndDate.Format("01-2006")
-			resp.EndDate = endDateStr
+	} else {
+		response.Offset = &req.Offset
+	}
+
+	if req.Envelope {
+		response.Total, err = g.subRepo.Count(ctx, filter)
+		if err != nil {
+			g.logger.Error().Err(err).Msg("Failed to count subscriptions")
+			return responses.SubList{}, errors.Wrap(err, "failed to count subscriptions")
 		}
-		response = append(response, resp)
+	}
+
+	response.Items = make([]responses.SubResponse, 0, len(subs))
+	for _, sub := range subs {
+		response.Items = append(response.Items, toSubResponse(ctx, sub))
 	}
 
 	return response, nil
 }
+
+// parseSubFilter validates the filters of the list and export endpoints.
+func parseSubFilter(req requests.SubscriptionFilter, log logger.Logger) (entities.SubscriptionFilter, error) {
+	var filter entities.SubscriptionFilter
+
+	if req.UserID != "" {
+		userID, err := uuid.Parse(req.UserID)
+		if err != nil {
+			log.Error().Err(err).Msg("Invalid user_id format")
+			return filter, errors.Wrap(ErrInvalidUUID, "failed to parse user_id")
+		}
+		filter.UserID = &userID
+	}
+
+	if req.ServiceName != "" {
+		filter.ServiceName = &req.ServiceName
+	}
+	if req.ServiceNamePrefix != "" {
+		filter.ServiceNamePrefix = &req.ServiceNamePrefix
+	}
+
+	if req.MinPrice != nil && req.MaxPrice != nil && *req.MinPrice > *req.MaxPrice {
+		log.Error().Msg("min_price is greater than max_price")
+		return filter, errors.Wrap(ErrInvalidFilter, "min_price is greater than max_price")
+	}
+	filter.MinPrice = req.MinPrice
+	filter.MaxPrice = req.MaxPrice
+
+	if req.ActiveAt != "" {
+		// A month keeps its earlier meaning: active on any day of that month.
+		activeFrom, err := parseStartDate(req.ActiveAt)
+		if err != nil {
+			log.Error().Err(err).Msg("Invalid active_at format")
+			return filter, errors.Wrap(err, "failed to parse active_at")
+		}
+		activeTo, _ := parseEndDate(req.ActiveAt)
+		filter.ActiveFrom = &activeFrom
+		filter.ActiveTo = &activeTo
+	}
+
+	if req.Status != "" {
+		for _, status := range strings.Split(req.Status, ",") {
+			if !slices.Contains(entities.SubscriptionStatuses, status) {
+				log.Error().Msg("Invalid status")
+				return filter, errors.Wrapf(ErrInvalidFilter, "unknown status %q", status)
+			}
+			filter.Statuses = append(filter.Statuses, status)
+		}
+		filter.StatusDate = today()
+	}
+
+	return filter, nil
+}
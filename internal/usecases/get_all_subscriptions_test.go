@@ -9,6 +9,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"subscription_service/internal/controllers/requests"
 	"subscription_service/internal/entities"
 )
 
@@ -24,7 +25,7 @@ func initGetListSubTestMocks(t *testing.T) {
 func TestGetListSubscriptions_Success(t *testing.T) {
 	initGetListSubTestMocks(t)
 	ctx := context.Background()
-	limit, offset := 10, 0
+	req := requests.GetListSubscriptions{Limit: 10, Offset: 0, Sort: "id", Order: "asc", Envelope: true}
 	startDate, _ := time.Parse("2006-01-02", "2025-07-01")
 	endDate, _ := time.Parse("2006-01-02", "2025-12-01")
 
@@ -47,36 +48,213 @@ func TestGetListSubscriptions_Success(t *testing.T) {
 		},
 	}
 
-	mockGetListSubRepo.EXPECT().SelectAll(ctx, limit, offset).Return(mockSubs, nil)
+	sort := entities.SubscriptionSort{Field: "id"}
+	mockGetListSubRepo.EXPECT().SelectAll(ctx, entities.SubscriptionFilter{}, sort, entities.Page{Limit: 10}).Return(mockSubs, nil)
+	mockGetListSubRepo.EXPECT().Count(ctx, entities.SubscriptionFilter{}).Return(12, nil)
 
 	useCase := NewGetListSubUseCase(mockGetListSubRepo, mockLogger)
-	response, err := useCase.GetListSubscriptions(ctx, limit, offset)
+	response, err := useCase.GetListSubscriptions(ctx, req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
-	assert.Equal(t, mockSubs[0].ServiceName, response[0].ServiceName)
-	assert.Equal(t, mockSubs[0].Price, response[0].Price)
-	assert.Equal(t, mockSubs[0].UserID.String(), response[0].UserID)
-	assert.Equal(t, "07-2025", response[0].StartDate)
-	assert.Equal(t, "12-2025", response[0].EndDate)
-	assert.Equal(t, mockSubs[1].ServiceName, response[1].ServiceName)
-	assert.Equal(t, mockSubs[1].Price, response[1].Price)
-	assert.Equal(t, mockSubs[1].UserID.String(), response[1].UserID)
-	assert.Equal(t, "07-2025", response[1].StartDate)
-	assert.Empty(t, response[1].EndDate)
+	assert.Equal(t, 12, response.Total)
+	assert.Equal(t, 10, response.Limit)
+	assert.Equal(t, 0, *response.Offset)
+	assert.Equal(t, mockSubs[0].ServiceName, response.Items[0].ServiceName)
+	assert.Equal(t, mockSubs[0].Price, response.Items[0].Price)
+	assert.Equal(t, mockSubs[0].UserID.String(), response.Items[0].UserID)
+	assert.Equal(t, "07-2025", response.Items[0].StartDate)
+	assert.Equal(t, "12-2025", response.Items[0].EndDate)
+	assert.Equal(t, mockSubs[1].ServiceName, response.Items[1].ServiceName)
+	assert.Equal(t, mockSubs[1].Price, response.Items[1].Price)
+	assert.Equal(t, mockSubs[1].UserID.String(), response.Items[1].UserID)
+	assert.Equal(t, "07-2025", response.Items[1].StartDate)
+	assert.Empty(t, response.Items[1].EndDate)
+}
+
+func TestGetListSubscriptions_Success_WithFilters(t *testing.T) {
+	initGetListSubTestMocks(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	minPrice, maxPrice := 100, 500
+	activeFrom, _ := time.Parse("2006-01-02", "2025-09-01")
+	activeTo, _ := time.Parse("2006-01-02", "2025-09-30")
+	req := requests.GetListSubscriptions{
+		Limit:  20,
+		Offset: 40,
+		SubscriptionFilter: requests.SubscriptionFilter{
+			UserID:            userID.String(),
+			ServiceNamePrefix: "yan",
+			MinPrice:          &minPrice,
+			MaxPrice:          &maxPrice,
+			ActiveAt:          "09-2025",
+		},
+		Sort:  "price",
+		Order: "desc",
+	}
+
+	prefix := "yan"
+	filter := entities.SubscriptionFilter{
+		UserID:            &userID,
+		ServiceNamePrefix: &prefix,
+		MinPrice:          &minPrice,
+		MaxPrice:          &maxPrice,
+		ActiveFrom:        &activeFrom,
+		ActiveTo:          &activeTo,
+	}
+	sort := entities.SubscriptionSort{Field: "price", Desc: true}
+	mockGetListSubRepo.EXPECT().SelectAll(ctx, filter, sort, entities.Page{Limit: 20, Offset: 40}).Return(nil, nil)
+
+	useCase := NewGetListSubUseCase(mockGetListSubRepo, mockLogger)
+	response, err := useCase.GetListSubscriptions(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Empty(t, response.Items)
+	assert.Empty(t, response.NextCursor)
+}
+
+func TestGetListSubscriptions_Success_Cursor(t *testing.T) {
+	initGetListSubTestMocks(t)
+	ctx := context.Background()
+	startDate, _ := time.Parse("2006-01-02", "2025-07-01")
+	mockSubs := []entities.Subscription{
+		{ID: uuid.New(), ServiceName: "Spotify", Price: 300, UserID: uuid.New(), StartDate: startDate},
+		{ID: uuid.New(), ServiceName: "Yandex Plus", Price: 400, CurrentPrice: 450, UserID: uuid.New(), StartDate: startDate},
+		{ID: uuid.New(), ServiceName: "Yandex Plus", Price: 500, UserID: uuid.New(), StartDate: startDate},
+	}
+	sort := entities.SubscriptionSort{Field: "price"}
+	useCase := NewGetListSubUseCase(mockGetListSubRepo, mockLogger)
+
+	first := ""
+	req := requests.GetListSubscriptions{Limit: 2, Cursor: &first, Sort: "price", Order: "asc"}
+	mockGetListSubRepo.EXPECT().SelectAll(ctx, entities.SubscriptionFilter{}, sort, entities.Page{Limit: 3}).Return(mockSubs, nil)
+
+	response, err := useCase.GetListSubscriptions(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Len(t, response.Items, 2)
+	assert.NotEmpty(t, response.NextCursor)
+
+	// The cursor holds the current price, which the list is sorted by.
+	req.Cursor = &response.NextCursor
+	after := &entities.Cursor{Value: "450", ID: mockSubs[1].ID}
+	mockGetListSubRepo.EXPECT().SelectAll(ctx, entities.SubscriptionFilter{}, sort, entities.Page{Limit: 3, After: after}).Return(mockSubs[2:], nil)
+
+	response, err = useCase.GetListSubscriptions(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Len(t, response.Items, 1)
+	assert.Empty(t, response.NextCursor)
+}
+
+func TestGetListSubscriptions_Failure_CursorForAnotherSort(t *testing.T) {
+	initGetListSubTestMocks(t)
+	ctx := context.Background()
+	sub := entities.Subscription{ID: uuid.New(), Price: 400}
+	cursor := encodeCursor(entities.SubscriptionSort{Field: "price"}, sub)
+	req := requests.GetListSubscriptions{Limit: 10, Cursor: &cursor, Sort: "price", Order: "desc"}
+
+	useCase := NewGetListSubUseCase(mockGetListSubRepo, mockLogger)
+	_, err := useCase.GetListSubscriptions(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestGetListSubscriptions_Failure_InvalidUserID(t *testing.T) {
+	initGetListSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.GetListSubscriptions{Limit: 10, SubscriptionFilter: requests.SubscriptionFilter{UserID: "invalid-uuid"}, Sort: "id", Order: "asc"}
+
+	useCase := NewGetListSubUseCase(mockGetListSubRepo, mockLogger)
+	_, err := useCase.GetListSubscriptions(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidUUID)
+}
+
+func TestGetListSubscriptions_Failure_InvalidPriceRange(t *testing.T) {
+	initGetListSubTestMocks(t)
+	ctx := context.Background()
+	minPrice, maxPrice := 500, 100
+	req := requests.GetListSubscriptions{Limit: 10, SubscriptionFilter: requests.SubscriptionFilter{MinPrice: &minPrice, MaxPrice: &maxPrice}, Sort: "id", Order: "asc"}
+
+	useCase := NewGetListSubUseCase(mockGetListSubRepo, mockLogger)
+	_, err := useCase.GetListSubscriptions(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidFilter)
+}
+
+func TestGetListSubscriptions_Failure_InvalidActiveAt(t *testing.T) {
+	initGetListSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.GetListSubscriptions{Limit: 10, SubscriptionFilter: requests.SubscriptionFilter{ActiveAt: "invalid-date"}, Sort: "id", Order: "asc"}
+
+	useCase := NewGetListSubUseCase(mockGetListSubRepo, mockLogger)
+	_, err := useCase.GetListSubscriptions(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidDateFormat)
+}
+
+func TestGetListSubscriptions_Failure_CountError(t *testing.T) {
+	initGetListSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.GetListSubscriptions{Limit: 10, Offset: 0, Sort: "id", Order: "asc", Envelope: true}
+
+	expectedErr := errors.New("database error")
+	mockGetListSubRepo.EXPECT().SelectAll(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockGetListSubRepo.EXPECT().Count(ctx, gomock.Any()).Return(0, expectedErr)
+
+	useCase := NewGetListSubUseCase(mockGetListSubRepo, mockLogger)
+	_, err := useCase.GetListSubscriptions(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, expectedErr)
 }
 
 func TestGetListSubscriptions_Failure_DatabaseError(t *testing.T) {
 	initGetListSubTestMocks(t)
 	ctx := context.Background()
-	limit, offset := 10, 0
+	req := requests.GetListSubscriptions{Limit: 10, Offset: 0, Sort: "id", Order: "asc"}
 
 	expectedErr := errors.New("database error")
-	mockGetListSubRepo.EXPECT().SelectAll(ctx, limit, offset).Return(nil, expectedErr)
+	mockGetListSubRepo.EXPECT().SelectAll(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, expectedErr)
 
 	useCase := NewGetListSubUseCase(mockGetListSubRepo, mockLogger)
-	_, err := useCase.GetListSubscriptions(ctx, limit, offset)
+	_, err := useCase.GetListSubscriptions(ctx, req)
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, expectedErr)
 }
+
+func TestGetListSubscriptions_Success_StatusFilter(t *testing.T) {
+	initGetListSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.GetListSubscriptions{Limit: 10, Sort: "id", Order: "asc", SubscriptionFilter: requests.SubscriptionFilter{Status: "active,trial"}}
+
+	filter := entities.SubscriptionFilter{
+		Statuses:   []string{entities.StatusActive, entities.StatusTrial},
+		StatusDate: today(),
+	}
+	sort := entities.SubscriptionSort{Field: "id"}
+	mockGetListSubRepo.EXPECT().SelectAll(ctx, filter, sort, entities.Page{Limit: 10}).Return(nil, nil)
+
+	useCase := NewGetListSubUseCase(mockGetListSubRepo, mockLogger)
+	_, err := useCase.GetListSubscriptions(ctx, req)
+
+	assert.NoError(t, err)
+}
+
+func TestGetListSubscriptions_Failure_InvalidStatus(t *testing.T) {
+	initGetListSubTestMocks(t)
+	ctx := context.Background()
+	req := requests.GetListSubscriptions{Limit: 10, Sort: "id", Order: "asc", SubscriptionFilter: requests.SubscriptionFilter{Status: "active,deleted"}}
+
+	useCase := NewGetListSubUseCase(mockGetListSubRepo, mockLogger)
+	_, err := useCase.GetListSubscriptions(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidFilter)
+}
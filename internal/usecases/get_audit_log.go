@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"time"
+)
+
+type GetAuditLogUseCase interface {
+	GetAuditLog(ctx context.Context, req requests.GetAuditLog) ([]responses.AuditEntry, error)
+}
+
+type getAuditLogUseCase struct {
+	auditRepo AuditLogRepository
+	logger    logger.Logger
+}
+
+func NewGetAuditLogUseCase(auditRepo AuditLogRepository, logger logger.Logger) GetAuditLogUseCase {
+	return &getAuditLogUseCase{
+		auditRepo: auditRepo,
+		logger:    logger,
+	}
+}
+
+// GetAuditLog lists recorded changes of all subscriptions. from and to are
+// days or months, both inclusive.
+func (g *getAuditLogUseCase) GetAuditLog(ctx context.Context, req requests.GetAuditLog) ([]responses.AuditEntry, error) {
+	filter := entities.AuditFilter{Limit: req.Limit, Offset: req.Offset}
+
+	if req.SubscriptionID != "" {
+		subID, err := uuid.Parse(req.SubscriptionID)
+		if err != nil {
+			g.logger.Error().Err(err).Msg("Invalid subscription_id format")
+			return nil, errors.Wrap(ErrInvalidUUID, "failed to parse subscription_id")
+		}
+		filter.SubscriptionID = &subID
+	}
+	if req.Actor != "" {
+		filter.Actor = &req.Actor
+	}
+	if req.Action != "" {
+		filter.Action = &req.Action
+	}
+
+	if req.From != "" {
+		from, err := parseStartDate(req.From)
+		if err != nil {
+			g.logger.Error().Err(err).Msg("Invalid from format")
+			return nil, errors.Wrap(err, "failed to parse from")
+		}
+		filter.From = &from
+	}
+	if req.To != "" {
+		to, err := parseEndDate(req.To)
+		if err != nil {
+			g.logger.Error().Err(err).Msg("Invalid to format")
+			return nil, errors.Wrap(err, "failed to parse to")
+		}
+		to = to.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		g.logger.Error().Msg("from is after to")
+		return nil, errors.Wrap(ErrInvalidPeriod, "from is after to")
+	}
+
+	entries, err := g.auditRepo.SelectAll(ctx, filter)
+	if err != nil {
+		g.logger.Error().Err(err).Msg("Failed to get audit log")
+		return nil, errors.Wrap(err, "failed to get audit log")
+	}
+
+	return toAuditResponses(entries), nil
+}
+
+func toAuditResponses(entries []entities.AuditEntry) []responses.AuditEntry {
+	items := make([]responses.AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		items = append(items, responses.AuditEntry{
+			ID:             entry.ID,
+			SubscriptionID: entry.SubscriptionID.String(),
+			Action:         entry.Action,
+			Actor:          entry.Actor,
+			RequestID:      entry.RequestID,
+			Before:         entry.Before,
+			After:          entry.After,
+			CreatedAt:      entry.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return items
+}
@@ -0,0 +1,133 @@
+package usecases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var mockAuditLogRepo *MockAuditLogRepository
+
+func initAuditLogTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAuditLogRepo = NewMockAuditLogRepository(ctrl)
+	mockLogger = logger.NewMockLogger(t)
+}
+
+func TestGetAuditLog_Success(t *testing.T) {
+	initAuditLogTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New()
+	createdAt, _ := time.Parse(time.RFC3339, "2025-07-20T10:00:00Z")
+	req := requests.GetAuditLog{
+		SubscriptionID: subID.String(),
+		Actor:          "alice",
+		Action:         entities.AuditActionUpdate,
+		From:           "2025-07-01",
+		To:             "07-2025",
+		Limit:          50,
+	}
+
+	mockAuditLogRepo.EXPECT().SelectAll(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, filter entities.AuditFilter) ([]entities.AuditEntry, error) {
+			assert.Equal(t, subID, *filter.SubscriptionID)
+			assert.Equal(t, "alice", *filter.Actor)
+			assert.Equal(t, entities.AuditActionUpdate, *filter.Action)
+			assert.Equal(t, "2025-07-01", filter.From.Format("2006-01-02"))
+			// to is inclusive, so the filter ends on the next day.
+			assert.Equal(t, "2025-08-01", filter.To.Format("2006-01-02"))
+			assert.Equal(t, 50, filter.Limit)
+			return []entities.AuditEntry{{
+				ID:             1,
+				SubscriptionID: subID,
+				Action:         entities.AuditActionUpdate,
+				Actor:          "alice",
+				RequestID:      "req-1",
+				Before:         []byte(`{"price":400}`),
+				After:          []byte(`{"price":500}`),
+				CreatedAt:      createdAt,
+			}}, nil
+		})
+
+	useCase := NewGetAuditLogUseCase(mockAuditLogRepo, mockLogger)
+	response, err := useCase.GetAuditLog(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Len(t, response, 1)
+	assert.Equal(t, subID.String(), response[0].SubscriptionID)
+	assert.Equal(t, "req-1", response[0].RequestID)
+	assert.JSONEq(t, `{"price":500}`, string(response[0].After))
+	assert.Equal(t, "2025-07-20T10:00:00Z", response[0].CreatedAt)
+}
+
+func TestGetAuditLog_Success_Empty(t *testing.T) {
+	initAuditLogTestMocks(t)
+	ctx := context.Background()
+
+	mockAuditLogRepo.EXPECT().SelectAll(ctx, entities.AuditFilter{Limit: 50}).Return(nil, nil)
+
+	useCase := NewGetAuditLogUseCase(mockAuditLogRepo, mockLogger)
+	response, err := useCase.GetAuditLog(ctx, requests.GetAuditLog{Limit: 50})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.Empty(t, response)
+}
+
+func TestGetAuditLog_Failure_InvalidSubscriptionID(t *testing.T) {
+	initAuditLogTestMocks(t)
+
+	useCase := NewGetAuditLogUseCase(mockAuditLogRepo, mockLogger)
+	_, err := useCase.GetAuditLog(context.Background(), requests.GetAuditLog{SubscriptionID: "invalid-uuid", Limit: 50})
+
+	assert.ErrorIs(t, err, ErrInvalidUUID)
+}
+
+func TestGetAuditLog_Failure_FromAfterTo(t *testing.T) {
+	initAuditLogTestMocks(t)
+
+	useCase := NewGetAuditLogUseCase(mockAuditLogRepo, mockLogger)
+	_, err := useCase.GetAuditLog(context.Background(), requests.GetAuditLog{From: "2025-08-01", To: "2025-07-31", Limit: 50})
+
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
+}
+
+func TestGetAuditLog_Failure_InvalidDate(t *testing.T) {
+	initAuditLogTestMocks(t)
+
+	useCase := NewGetAuditLogUseCase(mockAuditLogRepo, mockLogger)
+	_, err := useCase.GetAuditLog(context.Background(), requests.GetAuditLog{From: "yesterday", Limit: 50})
+
+	assert.ErrorIs(t, err, ErrInvalidDateFormat)
+}
+
+func TestGetAuditLog_Failure_RepositoryError(t *testing.T) {
+	initAuditLogTestMocks(t)
+	ctx := context.Background()
+
+	expectedErr := errors.New("database error")
+	mockAuditLogRepo.EXPECT().SelectAll(ctx, gomock.Any()).Return(nil, expectedErr)
+
+	useCase := NewGetAuditLogUseCase(mockAuditLogRepo, mockLogger)
+	_, err := useCase.GetAuditLog(ctx, requests.GetAuditLog{Limit: 50})
+
+	assert.ErrorIs(t, err, expectedErr)
+}
+
+func TestAuditInfo(t *testing.T) {
+	actor, requestID := AuditInfo(context.Background())
+	assert.Equal(t, SystemActor, actor)
+	assert.Empty(t, requestID)
+
+	actor, requestID = AuditInfo(WithAuditInfo(context.Background(), "alice", "req-1"))
+	assert.Equal(t, "alice", actor)
+	assert.Equal(t, "req-1", requestID)
+}
@@ -0,0 +1,53 @@
+package usecases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/pkg/logger"
+)
+
+type GetEndingTrialsUseCase interface {
+	GetEndingTrials(c context.Context, req requests.GetEndingTrials) ([]responses.SubResponse, error)
+}
+
+type getEndingTrialsUseCase struct {
+	subRepo EndingTrialsRepository
+	logger  logger.Logger
+}
+
+func NewGetEndingTrialsUseCase(subRepo EndingTrialsRepository, logger logger.Logger) GetEndingTrialsUseCase {
+	return &getEndingTrialsUseCase{
+		subRepo: subRepo,
+		logger:  logger,
+	}
+}
+
+// GetEndingTrials lists subscriptions whose trial ends between today and
+// today plus req.Within and which are charged afterwards.
+func (g *getEndingTrialsUseCase) GetEndingTrials(c context.Context, req requests.GetEndingTrials) ([]responses.SubResponse, error) {
+	if req.Within == "" {
+		req.Within = DefaultTrialsWithin
+	}
+
+	days, err := parseWithin(req.Within)
+	if err != nil {
+		g.logger.Error().Err(err).Msg("Invalid within value")
+		return nil, errors.Wrap(err, "failed to parse within")
+	}
+
+	from := today()
+	subs, err := g.subRepo.SelectTrialsEnding(c, from, from.AddDate(0, 0, days))
+	if err != nil {
+		g.logger.Error().Err(err).Msg("Failed to get ending trials")
+		return nil, errors.Wrap(err, "failed to get ending trials")
+	}
+
+	items := make([]responses.SubResponse, 0, len(subs))
+	for _, sub := range subs {
+		items = append(items, toSubResponse(c, sub))
+	}
+
+	return items, nil
+}
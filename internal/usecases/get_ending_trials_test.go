@@ -0,0 +1,103 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+)
+
+var (
+	mockEndingTrialsRepo *MockEndingTrialsRepository
+)
+
+func initGetEndingTrialsTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockEndingTrialsRepo = NewMockEndingTrialsRepository(ctrl)
+	mockLogger = logger.NewMockLogger(t)
+}
+
+func TestGetEndingTrials_Success(t *testing.T) {
+	initGetEndingTrialsTestMocks(t)
+	ctx := WithDateFormat(context.Background(), DateFormatISO)
+	startDate, _ := time.Parse("2006-01-02", "2025-07-01")
+	trialEnd, _ := time.Parse("2006-01-02", "2025-07-14")
+
+	subs := []entities.Subscription{
+		{ID: uuid.New(), ServiceName: "Yandex Plus", Price: 400, Currency: entities.DefaultCurrency, UserID: uuid.New(), StartDate: startDate, TrialStartDate: &startDate, TrialEndDate: &trialEnd},
+	}
+	mockEndingTrialsRepo.EXPECT().SelectTrialsEnding(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, from, to time.Time) ([]entities.Subscription, error) {
+			assert.Equal(t, 14, daysBetween(from, to))
+			return subs, nil
+		})
+
+	useCase := NewGetEndingTrialsUseCase(mockEndingTrialsRepo, mockLogger)
+	response, err := useCase.GetEndingTrials(ctx, requests.GetEndingTrials{Within: "2w"})
+
+	assert.NoError(t, err)
+	assert.Len(t, response, 1)
+	assert.Equal(t, "2025-07-01", response[0].TrialStart)
+	assert.Equal(t, "2025-07-14", response[0].TrialEnd)
+}
+
+func TestGetEndingTrials_Success_DefaultWithin(t *testing.T) {
+	initGetEndingTrialsTestMocks(t)
+	ctx := context.Background()
+
+	mockEndingTrialsRepo.EXPECT().SelectTrialsEnding(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, from, to time.Time) ([]entities.Subscription, error) {
+			assert.Equal(t, 7, daysBetween(from, to))
+			return nil, nil
+		})
+
+	useCase := NewGetEndingTrialsUseCase(mockEndingTrialsRepo, mockLogger)
+	response, err := useCase.GetEndingTrials(ctx, requests.GetEndingTrials{})
+
+	assert.NoError(t, err)
+	assert.Empty(t, response)
+}
+
+func TestGetEndingTrials_Failure_InvalidWithin(t *testing.T) {
+	initGetEndingTrialsTestMocks(t)
+	ctx := context.Background()
+
+	useCase := NewGetEndingTrialsUseCase(mockEndingTrialsRepo, mockLogger)
+	_, err := useCase.GetEndingTrials(ctx, requests.GetEndingTrials{Within: "7 days"})
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidFilter)
+}
+
+func TestGetEndingTrials_Failure_WithinTooLong(t *testing.T) {
+	initGetEndingTrialsTestMocks(t)
+	ctx := context.Background()
+	useCase := NewGetEndingTrialsUseCase(mockEndingTrialsRepo, mockLogger)
+
+	for _, within := range []string{"367d", "53w", "999999999999w"} {
+		_, err := useCase.GetEndingTrials(ctx, requests.GetEndingTrials{Within: within})
+
+		assert.ErrorIs(t, err, ErrInvalidFilter, within)
+	}
+}
+
+func TestGetEndingTrials_Failure_DatabaseError(t *testing.T) {
+	initGetEndingTrialsTestMocks(t)
+	ctx := context.Background()
+
+	expectedErr := errors.New("database error")
+	mockEndingTrialsRepo.EXPECT().SelectTrialsEnding(ctx, gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+
+	useCase := NewGetEndingTrialsUseCase(mockEndingTrialsRepo, mockLogger)
+	_, err := useCase.GetEndingTrials(ctx, requests.GetEndingTrials{Within: "7d"})
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, expectedErr)
+}
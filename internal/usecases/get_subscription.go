@@ -36,17 +36,5 @@ func (g *getSubUseCase) GetSubscription(c context.Context, subID string) (respon
 		return responses.SubResponse{}, errors.Wrap(err, "failed to get subscription")
 	}
 
-	response := responses.SubResponse{
-		ID:          sub.ID.String(),
-		ServiceName: sub.ServiceName,
-		Price:       sub.Price,
-		UserID:      sub.UserID.String(),
-		StartDate:   sub.StartDate.Format("01-2006"),
-	}
-	if sub.EndDate != nil {
-		endDateStr := sub.EndDate.Format("01-2006")
-		response.EndDate = endDateStr
-	}
-
-	return response, nil
+	return toSubResponse(c, sub), nil
 }
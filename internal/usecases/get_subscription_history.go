@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+)
+
+type GetSubHistoryUseCase interface {
+	GetSubscriptionHistory(ctx context.Context, subID string, req requests.GetSubHistory) ([]responses.AuditEntry, error)
+}
+
+type getSubHistoryUseCase struct {
+	auditRepo AuditLogRepository
+	logger    logger.Logger
+}
+
+func NewGetSubHistoryUseCase(auditRepo AuditLogRepository, logger logger.Logger) GetSubHistoryUseCase {
+	return &getSubHistoryUseCase{
+		auditRepo: auditRepo,
+		logger:    logger,
+	}
+}
+
+// GetSubscriptionHistory lists the recorded changes of one subscription,
+// oldest first. The history is kept after the subscription is purged;
+// changes made before the audit log was introduced aren't in it.
+func (g *getSubHistoryUseCase) GetSubscriptionHistory(ctx context.Context, subID string, req requests.GetSubHistory) ([]responses.AuditEntry, error) {
+	id, err := uuid.Parse(subID)
+	if err != nil {
+		g.logger.Error().Err(err).Msg("Invalid sub_id format")
+		return nil, errors.Wrap(ErrInvalidUUID, "failed to parse sub_id")
+	}
+
+	filter := entities.AuditFilter{SubscriptionID: &id, Limit: req.Limit, Offset: req.Offset}
+	entries, err := g.auditRepo.SelectAll(ctx, filter)
+	if err != nil {
+		g.logger.Error().Err(err).Msg("Failed to get subscription history")
+		return nil, errors.Wrap(err, "failed to get subscription history")
+	}
+
+	return toAuditResponses(entries), nil
+}
@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/entities"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSubscriptionHistory_Success(t *testing.T) {
+	initAuditLogTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New()
+
+	filter := entities.AuditFilter{SubscriptionID: &subID, Limit: 50}
+	mockAuditLogRepo.EXPECT().SelectAll(ctx, filter).Return([]entities.AuditEntry{
+		{ID: 1, SubscriptionID: subID, Action: entities.AuditActionCreate, Actor: "alice", After: []byte(`{"price":400}`)},
+		{ID: 2, SubscriptionID: subID, Action: entities.AuditActionDelete, Actor: "bob", Before: []byte(`{"price":400}`), After: []byte(`{"price":400}`)},
+	}, nil)
+
+	useCase := NewGetSubHistoryUseCase(mockAuditLogRepo, mockLogger)
+	response, err := useCase.GetSubscriptionHistory(ctx, subID.String(), requests.GetSubHistory{Limit: 50})
+
+	assert.NoError(t, err)
+	assert.Len(t, response, 2)
+	assert.Equal(t, entities.AuditActionCreate, response[0].Action)
+	assert.Nil(t, response[0].Before)
+	assert.Equal(t, "bob", response[1].Actor)
+}
+
+func TestGetSubscriptionHistory_Failure_InvalidID(t *testing.T) {
+	initAuditLogTestMocks(t)
+
+	useCase := NewGetSubHistoryUseCase(mockAuditLogRepo, mockLogger)
+	_, err := useCase.GetSubscriptionHistory(context.Background(), "invalid-uuid", requests.GetSubHistory{Limit: 50})
+
+	assert.ErrorIs(t, err, ErrInvalidUUID)
+}
+
+func TestGetSubscriptionHistory_Failure_RepositoryError(t *testing.T) {
+	initAuditLogTestMocks(t)
+	ctx := context.Background()
+
+	expectedErr := errors.New("database error")
+	mockAuditLogRepo.EXPECT().SelectAll(ctx, gomock.Any()).Return(nil, expectedErr)
+
+	useCase := NewGetSubHistoryUseCase(mockAuditLogRepo, mockLogger)
+	_, err := useCase.GetSubscriptionHistory(ctx, uuid.New().String(), requests.GetSubHistory{Limit: 50})
+
+	assert.ErrorIs(t, err, expectedErr)
+}
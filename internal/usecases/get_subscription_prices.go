@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/pkg/logger"
+)
+
+type getSubPricesUseCase struct {
+	subRepo   GetSubRepository
+	priceRepo SubPriceHistoryRepository
+	logger    logger.Logger
+}
+
+type GetSubPricesUseCase interface {
+	GetSubscriptionPrices(ctx context.Context, subID string) ([]responses.SubPrice, error)
+}
+
+func NewGetSubPricesUseCase(subRepo GetSubRepository, priceRepo SubPriceHistoryRepository, logger logger.Logger) GetSubPricesUseCase {
+	return &getSubPricesUseCase{
+		subRepo:   subRepo,
+		priceRepo: priceRepo,
+		logger:    logger,
+	}
+}
+
+// GetSubscriptionPrices returns the price history of the subscription, starting with the price set at creation.
+func (g *getSubPricesUseCase) GetSubscriptionPrices(ctx context.Context, subID string) ([]responses.SubPrice, error) {
+	id, err := uuid.Parse(subID)
+	if err != nil {
+		g.logger.Error().Err(err).Msg("Invalid sub_id format")
+		return nil, errors.Wrap(ErrInvalidUUID, "failed to parse sub_id")
+	}
+
+	sub, err := g.subRepo.SelectByID(ctx, subID)
+	if err != nil {
+		g.logger.Error().Err(err).Msg("Failed to get subscription")
+		return nil, errors.Wrap(err, "failed to get subscription")
+	}
+
+	prices, err := g.priceRepo.SelectBySubscriptionIDs(ctx, []uuid.UUID{id})
+	if err != nil {
+		g.logger.Error().Err(err).Msg("Failed to get subscription prices")
+		return nil, errors.Wrap(err, "failed to get subscription prices")
+	}
+
+	return newPriceSchedule(prices).history(ctx, sub), nil
+}
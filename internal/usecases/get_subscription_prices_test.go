@@ -0,0 +1,110 @@
+package usecases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockGetPricesSubRepo   *MockGetSubRepository
+	mockGetPricesPriceRepo *MockSubPriceHistoryRepository
+)
+
+func initGetSubPricesTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockGetPricesSubRepo = NewMockGetSubRepository(ctrl)
+	mockGetPricesPriceRepo = NewMockSubPriceHistoryRepository(ctrl)
+	mockLogger = logger.NewMockLogger(t)
+}
+
+func TestGetSubscriptionPrices_Success(t *testing.T) {
+	initGetSubPricesTestMocks(t)
+	ctx := context.Background()
+	startDate, _ := time.Parse("01-2006", "07-2025")
+	raisedFrom, _ := time.Parse("01-2006", "01-2026")
+	sub := entities.Subscription{ID: uuid.New(), Price: 400, UserID: uuid.New(), StartDate: startDate}
+	prices := []entities.SubscriptionPrice{{SubscriptionID: sub.ID, Price: 500, EffectiveFrom: raisedFrom}}
+
+	mockGetPricesSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+	mockGetPricesPriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, []uuid.UUID{sub.ID}).Return(prices, nil)
+
+	useCase := NewGetSubPricesUseCase(mockGetPricesSubRepo, mockGetPricesPriceRepo, mockLogger)
+	response, err := useCase.GetSubscriptionPrices(ctx, sub.ID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []responses.SubPrice{
+		{Price: 400, EffectiveFrom: "07-2025"},
+		{Price: 500, EffectiveFrom: "01-2026"},
+	}, response)
+}
+
+func TestGetSubscriptionPrices_Success_ISODates(t *testing.T) {
+	initGetSubPricesTestMocks(t)
+	ctx := WithDateFormat(context.Background(), DateFormatISO)
+	startDate, _ := time.Parse("2006-01-02", "2025-07-15")
+	raisedFrom, _ := time.Parse("01-2006", "01-2026")
+	sub := entities.Subscription{ID: uuid.New(), Price: 400, UserID: uuid.New(), StartDate: startDate}
+	prices := []entities.SubscriptionPrice{{SubscriptionID: sub.ID, Price: 500, EffectiveFrom: raisedFrom}}
+
+	mockGetPricesSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+	mockGetPricesPriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, []uuid.UUID{sub.ID}).Return(prices, nil)
+
+	useCase := NewGetSubPricesUseCase(mockGetPricesSubRepo, mockGetPricesPriceRepo, mockLogger)
+	response, err := useCase.GetSubscriptionPrices(ctx, sub.ID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []responses.SubPrice{
+		{Price: 400, EffectiveFrom: "2025-07-15"},
+		{Price: 500, EffectiveFrom: "2026-01-01"},
+	}, response)
+}
+
+func TestGetSubscriptionPrices_Failure_InvalidUUID(t *testing.T) {
+	initGetSubPricesTestMocks(t)
+	ctx := context.Background()
+
+	useCase := NewGetSubPricesUseCase(mockGetPricesSubRepo, mockGetPricesPriceRepo, mockLogger)
+	_, err := useCase.GetSubscriptionPrices(ctx, "invalid-uuid")
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidUUID)
+}
+
+func TestGetSubscriptionPrices_Failure_NotFound(t *testing.T) {
+	initGetSubPricesTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+
+	mockGetPricesSubRepo.EXPECT().SelectByID(ctx, subID).Return(entities.Subscription{}, ErrEntityNotFound)
+
+	useCase := NewGetSubPricesUseCase(mockGetPricesSubRepo, mockGetPricesPriceRepo, mockLogger)
+	_, err := useCase.GetSubscriptionPrices(ctx, subID)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestGetSubscriptionPrices_Failure_DatabaseError(t *testing.T) {
+	initGetSubPricesTestMocks(t)
+	ctx := context.Background()
+	sub := entities.Subscription{ID: uuid.New(), Price: 400, UserID: uuid.New(), StartDate: time.Now()}
+
+	expectedErr := errors.New("database error")
+	mockGetPricesSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+	mockGetPricesPriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, gomock.Any()).Return(nil, expectedErr)
+
+	useCase := NewGetSubPricesUseCase(mockGetPricesSubRepo, mockGetPricesPriceRepo, mockLogger)
+	_, err := useCase.GetSubscriptionPrices(ctx, sub.ID.String())
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, expectedErr)
+}
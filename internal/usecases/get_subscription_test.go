@@ -52,6 +52,56 @@ func TestGetSubscription_Success(t *testing.T) {
 	assert.Equal(t, "12-2025", response.EndDate)
 }
 
+func TestGetSubscription_Success_ISODates(t *testing.T) {
+	initGetSubTestMocks(t)
+	ctx := WithDateFormat(context.Background(), DateFormatISO)
+	subID := uuid.New().String()
+	startDate, _ := time.Parse("2006-01-02", "2025-07-20")
+	endDate, _ := time.Parse("2006-01-02", "2025-12-19")
+
+	mockSub := entities.Subscription{
+		ID:          uuid.MustParse(subID),
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		UserID:      uuid.New(),
+		StartDate:   startDate,
+		EndDate:     &endDate,
+	}
+
+	mockGetSubRepo.EXPECT().SelectByID(ctx, subID).Return(mockSub, nil)
+
+	useCase := NewGetSubUseCase(mockGetSubRepo, mockLogger)
+	response, err := useCase.GetSubscription(ctx, subID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2025-07-20", response.StartDate)
+	assert.Equal(t, "2025-12-19", response.EndDate)
+}
+
+func TestGetSubscription_Success_CurrentPrice(t *testing.T) {
+	initGetSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+	startDate, _ := time.Parse("2006-01-02", "2025-07-01")
+
+	mockSub := entities.Subscription{
+		ID:           uuid.MustParse(subID),
+		ServiceName:  "Yandex Plus",
+		Price:        400,
+		CurrentPrice: 500,
+		UserID:       uuid.New(),
+		StartDate:    startDate,
+	}
+
+	mockGetSubRepo.EXPECT().SelectByID(ctx, subID).Return(mockSub, nil)
+
+	useCase := NewGetSubUseCase(mockGetSubRepo, mockLogger)
+	response, err := useCase.GetSubscription(ctx, subID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 500, response.Price)
+}
+
 func TestGetSubscription_Failure_InvalidSubID(t *testing.T) {
 	initGetSubTestMocks(t)
 	ctx := context.Background()
@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+)
+
+type getSuggestionsUseCase struct {
+	suggestionRepo GetSuggestionsRepository
+	logger         logger.Logger
+}
+
+type GetSuggestionsUseCase interface {
+	GetSuggestions(ctx context.Context, userID string) ([]responses.Suggestion, error)
+}
+
+func NewGetSuggestionsUseCase(suggestionRepo GetSuggestionsRepository, logger logger.Logger) GetSuggestionsUseCase {
+	return &getSuggestionsUseCase{
+		suggestionRepo: suggestionRepo,
+		logger:         logger,
+	}
+}
+
+// GetSuggestions lists the suggestions of the user that are neither accepted
+// nor dismissed yet.
+func (g *getSuggestionsUseCase) GetSuggestions(ctx context.Context, userID string) ([]responses.Suggestion, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		g.logger.Error().Err(err).Msg("Invalid user_id format")
+		return nil, errors.Wrap(ErrInvalidUUID, "failed to parse user_id")
+	}
+
+	suggestions, err := g.suggestionRepo.SelectByUser(ctx, id)
+	if err != nil {
+		g.logger.Error().Err(err).Msg("Failed to get subscription suggestions")
+		return nil, errors.Wrap(err, "failed to get subscription suggestions")
+	}
+
+	response := make([]responses.Suggestion, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		response = append(response, toSuggestionResponse(suggestion))
+	}
+
+	return response, nil
+}
+
+func toSuggestionResponse(suggestion entities.SubscriptionSuggestion) responses.Suggestion {
+	return responses.Suggestion{
+		ID:              suggestion.ID.String(),
+		ServiceName:     suggestion.ServiceName,
+		Price:           suggestion.Price,
+		Currency:        suggestion.Currency,
+		BillingPeriod:   suggestion.BillingPeriod,
+		FirstChargeDate: suggestion.FirstChargeDate.Format(dayLayout),
+		LastChargeDate:  suggestion.LastChargeDate.Format(dayLayout),
+		Charges:         suggestion.Charges,
+	}
+}
@@ -0,0 +1,95 @@
+package usecases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var mockGetSuggestionsRepo *MockGetSuggestionsRepository
+
+func initGetSuggestionsTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockGetSuggestionsRepo = NewMockGetSuggestionsRepository(ctrl)
+	mockLogger = logger.NewMockLogger(t)
+}
+
+func TestGetSuggestions_Success(t *testing.T) {
+	initGetSuggestionsTestMocks(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	firstCharge, _ := time.Parse("2006-01-02", "2025-03-05")
+	lastCharge, _ := time.Parse("2006-01-02", "2025-05-06")
+	suggestion := entities.SubscriptionSuggestion{
+		ID:              uuid.New(),
+		UserID:          userID,
+		Merchant:        "NETFLIX COM",
+		ServiceName:     "NETFLIX.COM",
+		Price:           649,
+		Currency:        entities.DefaultCurrency,
+		BillingPeriod:   entities.BillingMonthly,
+		FirstChargeDate: firstCharge,
+		LastChargeDate:  lastCharge,
+		Charges:         3,
+		Status:          entities.SuggestionPending,
+	}
+
+	mockGetSuggestionsRepo.EXPECT().SelectByUser(ctx, userID).Return([]entities.SubscriptionSuggestion{suggestion}, nil)
+
+	useCase := NewGetSuggestionsUseCase(mockGetSuggestionsRepo, mockLogger)
+	response, err := useCase.GetSuggestions(ctx, userID.String())
+
+	assert.NoError(t, err)
+	assert.Len(t, response, 1)
+	assert.Equal(t, suggestion.ID.String(), response[0].ID)
+	assert.Equal(t, "NETFLIX.COM", response[0].ServiceName)
+	assert.Equal(t, 649, response[0].Price)
+	assert.Equal(t, "2025-03-05", response[0].FirstChargeDate)
+	assert.Equal(t, "2025-05-06", response[0].LastChargeDate)
+	assert.Equal(t, 3, response[0].Charges)
+}
+
+func TestGetSuggestions_Success_Empty(t *testing.T) {
+	initGetSuggestionsTestMocks(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockGetSuggestionsRepo.EXPECT().SelectByUser(ctx, userID).Return(nil, nil)
+
+	useCase := NewGetSuggestionsUseCase(mockGetSuggestionsRepo, mockLogger)
+	response, err := useCase.GetSuggestions(ctx, userID.String())
+
+	assert.NoError(t, err)
+	assert.NotNil(t, response)
+	assert.Empty(t, response)
+}
+
+func TestGetSuggestions_Failure_InvalidUserID(t *testing.T) {
+	initGetSuggestionsTestMocks(t)
+
+	useCase := NewGetSuggestionsUseCase(mockGetSuggestionsRepo, mockLogger)
+	_, err := useCase.GetSuggestions(context.Background(), "invalid-uuid")
+
+	assert.ErrorIs(t, err, ErrInvalidUUID)
+}
+
+func TestGetSuggestions_Failure_RepositoryError(t *testing.T) {
+	initGetSuggestionsTestMocks(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	dbErr := errors.New("database error")
+
+	mockGetSuggestionsRepo.EXPECT().SelectByUser(ctx, userID).Return(nil, dbErr)
+
+	useCase := NewGetSuggestionsUseCase(mockGetSuggestionsRepo, mockLogger)
+	_, err := useCase.GetSuggestions(ctx, userID.String())
+
+	assert.ErrorIs(t, err, dbErr)
+}
@@ -0,0 +1,76 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"time"
+)
+
+type getUserCalendarUseCase struct {
+	subRepo   UserCalendarRepository
+	priceRepo SubPriceHistoryRepository
+	logger    logger.Logger
+}
+
+type GetUserCalendarUseCase interface {
+	GetUserCalendar(ctx context.Context, userID string) ([]byte, error)
+}
+
+func NewGetUserCalendarUseCase(subRepo UserCalendarRepository, priceRepo SubPriceHistoryRepository, logger logger.Logger) GetUserCalendarUseCase {
+	return &getUserCalendarUseCase{
+		subRepo:   subRepo,
+		priceRepo: priceRepo,
+		logger:    logger,
+	}
+}
+
+// GetUserCalendar renders the renewals of the user's subscriptions that have
+// not ended yet as an iCalendar feed, one recurring event per subscription.
+// Each event shows the price effective at the next renewal.
+func (g *getUserCalendarUseCase) GetUserCalendar(ctx context.Context, userID string) ([]byte, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		g.logger.Error().Err(err).Msg("Invalid user_id format")
+		return nil, errors.Wrap(ErrInvalidUUID, "failed to parse user_id")
+	}
+
+	day := today()
+	filter := entities.SubscriptionFilter{UserID: &id, ActiveFrom: &day}
+	sort := entities.SubscriptionSort{Field: "start_date"}
+
+	var subs []entities.Subscription
+	err = g.subRepo.Stream(ctx, filter, sort, func(sub entities.Subscription) error {
+		subs = append(subs, sub)
+		return nil
+	})
+	if err != nil {
+		g.logger.Error().Err(err).Msg("Failed to get subscriptions")
+		return nil, errors.Wrap(err, "failed to get subscriptions")
+	}
+
+	schedule := priceSchedule{}
+	if len(subs) > 0 {
+		subIDs := make([]uuid.UUID, 0, len(subs))
+		for _, sub := range subs {
+			subIDs = append(subIDs, sub.ID)
+		}
+
+		prices, err := g.priceRepo.SelectBySubscriptionIDs(ctx, subIDs)
+		if err != nil {
+			g.logger.Error().Err(err).Msg("Failed to get subscription prices")
+			return nil, errors.Wrap(err, "failed to get subscription prices")
+		}
+		schedule = newPriceSchedule(prices)
+	}
+
+	calendar := newCalendar(time.Now())
+	for _, sub := range subs {
+		next := max(monthIndex(day), monthIndex(billingStart(sub)))
+		calendar.add(calendarEvent{sub: sub, price: schedule.priceAt(sub, next)})
+	}
+
+	return calendar.bytes(), nil
+}
@@ -0,0 +1,168 @@
+package usecases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"strings"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockCalendarSubRepo   *MockUserCalendarRepository
+	mockCalendarPriceRepo *MockSubPriceHistoryRepository
+)
+
+func initGetUserCalendarTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCalendarSubRepo = NewMockUserCalendarRepository(ctrl)
+	mockCalendarPriceRepo = NewMockSubPriceHistoryRepository(ctrl)
+	mockLogger = logger.NewMockLogger(t)
+}
+
+func TestGetUserCalendar_Success(t *testing.T) {
+	initGetUserCalendarTestMocks(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	startDate, _ := time.Parse("2006-01-02", "2025-01-31")
+	endDate, _ := time.Parse("2006-01-02", "2099-12-31")
+	raisedFrom, _ := time.Parse("01-2006", "02-2025")
+	sub := entities.Subscription{
+		ID:            uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+		ServiceName:   "Yandex Plus, семейная",
+		Price:         400,
+		Currency:      entities.DefaultCurrency,
+		BillingPeriod: entities.BillingMonthly,
+		UserID:        userID,
+		StartDate:     startDate,
+		EndDate:       &endDate,
+		Version:       3,
+	}
+	prices := []entities.SubscriptionPrice{{SubscriptionID: sub.ID, Price: 500, EffectiveFrom: raisedFrom}}
+
+	mockCalendarSubRepo.EXPECT().Stream(ctx, gomock.Any(), entities.SubscriptionSort{Field: "start_date"}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, filter entities.SubscriptionFilter, _ entities.SubscriptionSort, fn func(entities.Subscription) error) error {
+			assert.Equal(t, userID, *filter.UserID)
+			assert.Equal(t, today(), *filter.ActiveFrom)
+			return fn(sub)
+		})
+	mockCalendarPriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, []uuid.UUID{sub.ID}).Return(prices, nil)
+
+	useCase := NewGetUserCalendarUseCase(mockCalendarSubRepo, mockCalendarPriceRepo, mockLogger)
+	calendar, err := useCase.GetUserCalendar(ctx, userID.String())
+
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(calendar), "\r\n"), "\r\n")
+	assert.Equal(t, "BEGIN:VCALENDAR", lines[0])
+	assert.Equal(t, "END:VCALENDAR", lines[len(lines)-1])
+	assert.Contains(t, lines, "UID:11111111-1111-1111-1111-111111111111@subscription-service")
+	assert.Contains(t, lines, "SEQUENCE:3")
+	assert.Contains(t, lines, "DTSTART;VALUE=DATE:20250131")
+	assert.Contains(t, lines, "DTEND;VALUE=DATE:20250201")
+	assert.Contains(t, lines, "RRULE:FREQ=MONTHLY;BYMONTHDAY=28,29,30,31;BYSETPOS=-1;UNTIL=20991231")
+	assert.Contains(t, lines, `SUMMARY:Yandex Plus\, семейная — 500 RUB`)
+	for _, line := range lines {
+		assert.LessOrEqual(t, len(line), calendarLineOctets)
+	}
+}
+
+func TestGetUserCalendar_Success_StartsAfterTrial(t *testing.T) {
+	initGetUserCalendarTestMocks(t)
+	ctx := context.Background()
+	startDate, _ := time.Parse("2006-01-02", "2025-07-01")
+	trialEnd, _ := time.Parse("2006-01-02", "2025-07-14")
+	sub := entities.Subscription{
+		ID:             uuid.New(),
+		ServiceName:    "Okko",
+		Price:          300,
+		Currency:       entities.DefaultCurrency,
+		BillingPeriod:  entities.BillingQuarterly,
+		UserID:         uuid.New(),
+		StartDate:      startDate,
+		TrialStartDate: &startDate,
+		TrialEndDate:   &trialEnd,
+	}
+
+	mockCalendarSubRepo.EXPECT().Stream(ctx, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(streamSubs([]entities.Subscription{sub}))
+	mockCalendarPriceRepo.EXPECT().SelectBySubscriptionIDs(ctx, []uuid.UUID{sub.ID}).Return(nil, nil)
+
+	useCase := NewGetUserCalendarUseCase(mockCalendarSubRepo, mockCalendarPriceRepo, mockLogger)
+	calendar, err := useCase.GetUserCalendar(ctx, sub.UserID.String())
+
+	assert.NoError(t, err)
+	assert.Contains(t, string(calendar), "DTSTART;VALUE=DATE:20250715\r\n")
+	assert.Contains(t, string(calendar), "RRULE:FREQ=MONTHLY;INTERVAL=3\r\n")
+}
+
+func TestGetUserCalendar_Success_NoSubscriptions(t *testing.T) {
+	initGetUserCalendarTestMocks(t)
+	ctx := context.Background()
+
+	mockCalendarSubRepo.EXPECT().Stream(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	useCase := NewGetUserCalendarUseCase(mockCalendarSubRepo, mockCalendarPriceRepo, mockLogger)
+	calendar, err := useCase.GetUserCalendar(ctx, uuid.New().String())
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(calendar), "BEGIN:VCALENDAR\r\n"))
+	assert.NotContains(t, string(calendar), "BEGIN:VEVENT")
+}
+
+func TestGetUserCalendar_Failure_InvalidUserID(t *testing.T) {
+	initGetUserCalendarTestMocks(t)
+
+	useCase := NewGetUserCalendarUseCase(mockCalendarSubRepo, mockCalendarPriceRepo, mockLogger)
+	_, err := useCase.GetUserCalendar(context.Background(), "invalid-uuid")
+
+	assert.ErrorIs(t, err, ErrInvalidUUID)
+}
+
+func TestGetUserCalendar_Failure_DatabaseError(t *testing.T) {
+	initGetUserCalendarTestMocks(t)
+	ctx := context.Background()
+	expectedErr := errors.New("database error")
+
+	mockCalendarSubRepo.EXPECT().Stream(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedErr)
+
+	useCase := NewGetUserCalendarUseCase(mockCalendarSubRepo, mockCalendarPriceRepo, mockLogger)
+	_, err := useCase.GetUserCalendar(ctx, uuid.New().String())
+
+	assert.ErrorIs(t, err, expectedErr)
+}
+
+func TestRecurrenceRule(t *testing.T) {
+	day := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+	endDate := day("2026-06-30")
+
+	assert.Equal(t, "FREQ=WEEKLY", recurrenceRule(entities.BillingWeekly, day("2025-07-03"), nil))
+	assert.Equal(t, "FREQ=MONTHLY;UNTIL=20260630", recurrenceRule(entities.BillingMonthly, day("2025-07-15"), &endDate))
+	assert.Equal(t, "FREQ=MONTHLY;BYMONTHDAY=28,29,30;BYSETPOS=-1", recurrenceRule(entities.BillingMonthly, day("2025-04-30"), nil))
+	assert.Equal(t, "FREQ=YEARLY", recurrenceRule(entities.BillingYearly, day("2025-07-31"), nil))
+	assert.Equal(t, "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=28,29;BYSETPOS=-1", recurrenceRule(entities.BillingYearly, day("2024-02-29"), nil))
+}
+
+func TestCalendarLine_FoldsLongLines(t *testing.T) {
+	c := &calendar{}
+	c.line("DESCRIPTION:" + strings.Repeat("подписка ", 20))
+
+	folded := strings.Split(strings.TrimSuffix(c.builder.String(), "\r\n"), "\r\n")
+	assert.Greater(t, len(folded), 1)
+	unfolded := folded[0]
+	for _, line := range folded {
+		assert.LessOrEqual(t, len(line), calendarLineOctets)
+	}
+	for _, line := range folded[1:] {
+		assert.True(t, strings.HasPrefix(line, " "))
+		unfolded += line[1:]
+	}
+	assert.Equal(t, "DESCRIPTION:"+strings.Repeat("подписка ", 20), unfolded)
+}
@@ -0,0 +1,98 @@
+package usecases
+
+import (
+	"context"
+	"io"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type importStatementUseCase struct {
+	subRepo        StatementSubsRepository
+	suggestionRepo ImportStatementRepository
+	logger         logger.Logger
+}
+
+type ImportStatementUseCase interface {
+	ImportStatement(ctx context.Context, userID string, body io.Reader, req requests.ImportStatement) (responses.ImportStatement, error)
+}
+
+func NewImportStatementUseCase(subRepo StatementSubsRepository, suggestionRepo ImportStatementRepository, logger logger.Logger) ImportStatementUseCase {
+	return &importStatementUseCase{
+		subRepo:        subRepo,
+		suggestionRepo: suggestionRepo,
+		logger:         logger,
+	}
+}
+
+// ImportStatement looks for recurring debits in an OFX or CAMT.053 bank
+// statement and saves them as subscription suggestions for the user. Debits
+// to a service the user already has a running subscription for are skipped,
+// and importing an overlapping statement again updates the suggestions
+// instead of duplicating them. The statement itself is not stored.
+func (i *importStatementUseCase) ImportStatement(ctx context.Context, userID string, body io.Reader, req requests.ImportStatement) (responses.ImportStatement, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		i.logger.Error().Err(err).Msg("Invalid user_id format")
+		return responses.ImportStatement{}, errors.Wrap(ErrInvalidUUID, "failed to parse user_id")
+	}
+
+	format, transactions, err := parseStatement(body, req.Format)
+	if err != nil {
+		i.logger.Error().Err(err).Msg("Failed to parse bank statement")
+		return responses.ImportStatement{}, err
+	}
+
+	response := responses.ImportStatement{Format: format, Transactions: len(transactions)}
+	for _, transaction := range transactions {
+		if transaction.IsDebit() {
+			response.Debits++
+		}
+	}
+
+	tracked, err := i.trackedMerchants(ctx, id)
+	if err != nil {
+		return responses.ImportStatement{}, err
+	}
+
+	var suggestions []entities.SubscriptionSuggestion
+	for _, suggestion := range detectRecurringDebits(id, transactions) {
+		if !tracked[suggestion.Merchant] {
+			suggestions = append(suggestions, suggestion)
+		}
+	}
+	response.Suggested = len(suggestions)
+
+	if len(suggestions) > 0 {
+		if err := i.suggestionRepo.Upsert(ctx, suggestions); err != nil {
+			i.logger.Error().Err(err).Msg("Failed to save subscription suggestions")
+			return responses.ImportStatement{}, errors.Wrap(err, "failed to save subscription suggestions")
+		}
+	}
+
+	return response, nil
+}
+
+// trackedMerchants returns the merchant keys of the services the user has a
+// subscription for that has not ended yet.
+func (i *importStatementUseCase) trackedMerchants(ctx context.Context, userID uuid.UUID) (map[string]bool, error) {
+	day := today()
+	filter := entities.SubscriptionFilter{UserID: &userID, ActiveFrom: &day}
+
+	tracked := make(map[string]bool)
+	err := i.subRepo.Stream(ctx, filter, entities.SubscriptionSort{Field: "id"}, func(sub entities.Subscription) error {
+		tracked[merchantKey(sub.ServiceName)] = true
+		return nil
+	})
+	if err != nil {
+		i.logger.Error().Err(err).Msg("Failed to get subscriptions")
+		return nil, errors.Wrap(err, "failed to get subscriptions")
+	}
+
+	return tracked, nil
+}
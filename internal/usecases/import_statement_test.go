@@ -0,0 +1,215 @@
+package usecases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"strings"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockStatementSubRepo  *MockStatementSubsRepository
+	mockImportSuggestions *MockImportStatementRepository
+)
+
+func initImportStatementTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStatementSubRepo = NewMockStatementSubsRepository(ctrl)
+	mockImportSuggestions = NewMockImportStatementRepository(ctrl)
+	mockLogger = logger.NewMockLogger(t)
+}
+
+const testOFXStatement = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+ENCODING:USASCII
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>RUB
+<BANKTRANLIST>
+<DTSTART>20250301
+<DTEND>20250630
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20250305120000.000[+3:MSK]<TRNAMT>-599.00<FITID>1<NAME>NETFLIX.COM 866-579-7172</STMTTRN>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20250312<TRNAMT>-1520.40<FITID>2<NAME>SPAR 0412</STMTTRN>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20250404<TRNAMT>-599.00<FITID>3<NAME>Netflix.com</STMTTRN>
+<STMTTRN><TRNTYPE>CREDIT<DTPOSTED>20250410<TRNAMT>85000.00<FITID>4<NAME>ЗАРПЛАТА</STMTTRN>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20250506<TRNAMT>-649.00<FITID>5<NAME>NETFLIX.COM 866-579-7172</STMTTRN>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20250506<TRNAMT>-649.00<FITID>5<NAME>NETFLIX.COM 866-579-7172</STMTTRN>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20250520<TRNAMT>-310.00<FITID>6<NAME>SPAR 0412</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`
+
+const testCAMT053Statement = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08">
+<BkToCstmrStmt><Stmt>
+<Ntry><Amt Ccy="EUR">10.99</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts><Cd>BOOK</Cd></Sts><BookgDt><Dt>2025-01-28</Dt></BookgDt><AcctSvcrRef>A1</AcctSvcrRef>
+<NtryDtls><TxDtls><RltdPties><Cdtr><Pty><Nm>Spotify AB</Nm></Pty></Cdtr></RltdPties></TxDtls></NtryDtls></Ntry>
+<Ntry><Amt Ccy="EUR">10.99</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts><Cd>BOOK</Cd></Sts><BookgDt><DtTm>2025-02-28T09:15:00</DtTm></BookgDt><AcctSvcrRef>A2</AcctSvcrRef>
+<NtryDtls><TxDtls><RltdPties><Cdtr><Pty><Nm>Spotify AB</Nm></Pty></Cdtr></RltdPties></TxDtls></NtryDtls></Ntry>
+<Ntry><Amt Ccy="EUR">10.99</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts><Cd>BOOK</Cd></Sts><BookgDt><Dt>2025-03-28</Dt></BookgDt><AcctSvcrRef>A3</AcctSvcrRef>
+<NtryDtls><TxDtls><RmtInf><Ustrd>Spotify AB P2F4C1</Ustrd></RmtInf></TxDtls></NtryDtls></Ntry>
+<Ntry><Amt Ccy="EUR">10.99</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts><Cd>PDNG</Cd></Sts><BookgDt><Dt>2025-04-28</Dt></BookgDt><AcctSvcrRef>A4</AcctSvcrRef>
+<NtryDtls><TxDtls><RltdPties><Cdtr><Pty><Nm>Spotify AB</Nm></Pty></Cdtr></RltdPties></TxDtls></NtryDtls></Ntry>
+<Ntry><Amt Ccy="EUR">2500,00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts>BOOK</Sts><BookgDt><Dt>2025-03-31</Dt></BookgDt><AcctSvcrRef>A5</AcctSvcrRef></Ntry>
+</Stmt></BkToCstmrStmt>
+</Document>
+`
+
+func TestImportStatement_Success_OFX(t *testing.T) {
+	initImportStatementTestMocks(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockStatementSubRepo.EXPECT().Stream(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, filter entities.SubscriptionFilter, _ entities.SubscriptionSort, _ func(entities.Subscription) error) error {
+			assert.Equal(t, userID, *filter.UserID)
+			assert.Equal(t, today(), *filter.ActiveFrom)
+			return nil
+		})
+	mockImportSuggestions.EXPECT().Upsert(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, suggestions []entities.SubscriptionSuggestion) error {
+			assert.Len(t, suggestions, 1)
+			suggestion := suggestions[0]
+			assert.Equal(t, userID, suggestion.UserID)
+			assert.Equal(t, "NETFLIX COM", suggestion.Merchant)
+			assert.Equal(t, "NETFLIX.COM", suggestion.ServiceName)
+			assert.Equal(t, 649, suggestion.Price)
+			assert.Equal(t, "RUB", suggestion.Currency)
+			assert.Equal(t, entities.BillingMonthly, suggestion.BillingPeriod)
+			assert.Equal(t, "2025-03-05", suggestion.FirstChargeDate.Format(dayLayout))
+			assert.Equal(t, "2025-05-06", suggestion.LastChargeDate.Format(dayLayout))
+			assert.Equal(t, 3, suggestion.Charges)
+			assert.Equal(t, entities.SuggestionPending, suggestion.Status)
+			return nil
+		})
+
+	useCase := NewImportStatementUseCase(mockStatementSubRepo, mockImportSuggestions, mockLogger)
+	response, err := useCase.ImportStatement(ctx, userID.String(), strings.NewReader(testOFXStatement), requests.ImportStatement{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, StatementFormatOFX, response.Format)
+	assert.Equal(t, 7, response.Transactions)
+	assert.Equal(t, 6, response.Debits)
+	assert.Equal(t, 1, response.Suggested)
+}
+
+func TestImportStatement_Success_CAMT053(t *testing.T) {
+	initImportStatementTestMocks(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockStatementSubRepo.EXPECT().Stream(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	mockImportSuggestions.EXPECT().Upsert(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, suggestions []entities.SubscriptionSuggestion) error {
+			assert.Len(t, suggestions, 1)
+			assert.Equal(t, "SPOTIFY AB", suggestions[0].Merchant)
+			assert.Equal(t, "Spotify AB", suggestions[0].ServiceName)
+			assert.Equal(t, 11, suggestions[0].Price)
+			assert.Equal(t, "EUR", suggestions[0].Currency)
+			assert.Equal(t, "2025-01-28", suggestions[0].FirstChargeDate.Format(dayLayout))
+			assert.Equal(t, "2025-03-28", suggestions[0].LastChargeDate.Format(dayLayout))
+			return nil
+		})
+
+	useCase := NewImportStatementUseCase(mockStatementSubRepo, mockImportSuggestions, mockLogger)
+	response, err := useCase.ImportStatement(ctx, userID.String(), strings.NewReader(testCAMT053Statement), requests.ImportStatement{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, StatementFormatCAMT053, response.Format)
+	assert.Equal(t, 4, response.Transactions)
+	assert.Equal(t, 3, response.Debits)
+	assert.Equal(t, 1, response.Suggested)
+}
+
+func TestImportStatement_Success_SkipsTrackedService(t *testing.T) {
+	initImportStatementTestMocks(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	tracked := []entities.Subscription{{ID: uuid.New(), ServiceName: "Netflix.com", UserID: userID}}
+
+	mockStatementSubRepo.EXPECT().Stream(ctx, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(streamSubs(tracked))
+
+	useCase := NewImportStatementUseCase(mockStatementSubRepo, mockImportSuggestions, mockLogger)
+	response, err := useCase.ImportStatement(ctx, userID.String(), strings.NewReader(testOFXStatement), requests.ImportStatement{Format: StatementFormatOFX})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, response.Suggested)
+}
+
+func TestImportStatement_Success_NoRecurringDebits(t *testing.T) {
+	initImportStatementTestMocks(t)
+	ctx := context.Background()
+	// the Okko series lapsed two months before the statement ends and the
+	// Ivi charges differ by more than 10%
+	statement := `<OFX><CURDEF>RUB<BANKTRANLIST>
+<STMTTRN><DTPOSTED>20250110<TRNAMT>-399.00<NAME>OKKO</STMTTRN>
+<STMTTRN><DTPOSTED>20250210<TRNAMT>-399.00<NAME>OKKO</STMTTRN>
+<STMTTRN><DTPOSTED>20250310<TRNAMT>-399.00<NAME>OKKO</STMTTRN>
+<STMTTRN><DTPOSTED>20250315<TRNAMT>-199.00<NAME>IVI</STMTTRN>
+<STMTTRN><DTPOSTED>20250415<TRNAMT>-399.00<NAME>IVI</STMTTRN>
+<STMTTRN><DTPOSTED>20250515<TRNAMT>-199.00<NAME>IVI</STMTTRN>
+<STMTTRN><DTPOSTED>20250520<TRNAMT>-50.00<NAME>METRO</STMTTRN>
+</BANKTRANLIST></OFX>`
+
+	mockStatementSubRepo.EXPECT().Stream(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	useCase := NewImportStatementUseCase(mockStatementSubRepo, mockImportSuggestions, mockLogger)
+	response, err := useCase.ImportStatement(ctx, uuid.New().String(), strings.NewReader(statement), requests.ImportStatement{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 7, response.Debits)
+	assert.Equal(t, 0, response.Suggested)
+}
+
+func TestImportStatement_Failure_InvalidUserID(t *testing.T) {
+	initImportStatementTestMocks(t)
+
+	useCase := NewImportStatementUseCase(mockStatementSubRepo, mockImportSuggestions, mockLogger)
+	_, err := useCase.ImportStatement(context.Background(), "invalid-uuid", strings.NewReader(testOFXStatement), requests.ImportStatement{})
+
+	assert.ErrorIs(t, err, ErrInvalidUUID)
+}
+
+func TestImportStatement_Failure_UnknownFormat(t *testing.T) {
+	initImportStatementTestMocks(t)
+
+	useCase := NewImportStatementUseCase(mockStatementSubRepo, mockImportSuggestions, mockLogger)
+	_, err := useCase.ImportStatement(context.Background(), uuid.New().String(), strings.NewReader("date,amount\n"), requests.ImportStatement{})
+
+	assert.ErrorIs(t, err, ErrInvalidField)
+}
+
+func TestImportStatement_Failure_InvalidAmount(t *testing.T) {
+	initImportStatementTestMocks(t)
+	statement := `<OFX><BANKTRANLIST><STMTTRN><DTPOSTED>20250110<TRNAMT>abc<NAME>OKKO</STMTTRN></BANKTRANLIST></OFX>`
+
+	useCase := NewImportStatementUseCase(mockStatementSubRepo, mockImportSuggestions, mockLogger)
+	_, err := useCase.ImportStatement(context.Background(), uuid.New().String(), strings.NewReader(statement), requests.ImportStatement{})
+
+	assert.ErrorIs(t, err, ErrInvalidField)
+	assert.Contains(t, err.Error(), "TRNAMT")
+}
+
+func TestImportStatement_Failure_UpsertError(t *testing.T) {
+	initImportStatementTestMocks(t)
+	ctx := context.Background()
+	dbErr := errors.New("database error")
+
+	mockStatementSubRepo.EXPECT().Stream(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	mockImportSuggestions.EXPECT().Upsert(ctx, gomock.Any()).Return(dbErr)
+
+	useCase := NewImportStatementUseCase(mockStatementSubRepo, mockImportSuggestions, mockLogger)
+	_, err := useCase.ImportStatement(ctx, uuid.New().String(), strings.NewReader(testOFXStatement), requests.ImportStatement{})
+
+	assert.ErrorIs(t, err, dbErr)
+}
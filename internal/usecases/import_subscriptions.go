@@ -0,0 +1,225 @@
+package usecases
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// MaxImportRows limits the number of data rows in one CSV import.
+const MaxImportRows = 50000
+
+// importFields lists the SubRequest fields a CSV column can fill.
+var importFields = []string{
+	"service_name",
+	"price",
+	"currency",
+	"billing_period",
+	"user_id",
+	"start_date",
+	"end_date",
+	"trial_start_date",
+	"trial_end_date",
+}
+
+var requiredImportFields = []string{"service_name", "price", "user_id", "start_date"}
+
+type importSubsUseCase struct {
+	subRepo ImportSubsRepository
+	logger  logger.Logger
+}
+
+type ImportSubsUseCase interface {
+	ImportSubscriptions(ctx context.Context, body io.Reader, req requests.ImportSubscriptions) (responses.ImportSubscriptions, error)
+}
+
+func NewImportSubsUseCase(subRepo ImportSubsRepository, logger logger.Logger) ImportSubsUseCase {
+	return &importSubsUseCase{
+		subRepo: subRepo,
+		logger:  logger,
+	}
+}
+
+// ImportSubscriptions reads subscriptions from CSV with a header row and
+// validates every row the way a single create does. A dry run only reports
+// the invalid rows; a real run also inserts the valid ones in one transaction.
+func (i *importSubsUseCase) ImportSubscriptions(ctx context.Context, body io.Reader, req requests.ImportSubscriptions) (responses.ImportSubscriptions, error) {
+	delimiter, err := parseDelimiter(req.Delimiter)
+	if err != nil {
+		i.logger.Error().Err(err).Msg("Invalid delimiter")
+		return responses.ImportSubscriptions{}, err
+	}
+
+	mapping, err := parseImportMapping(req.Mapping)
+	if err != nil {
+		i.logger.Error().Err(err).Msg("Invalid column mapping")
+		return responses.ImportSubscriptions{}, err
+	}
+
+	reader := csv.NewReader(body)
+	reader.Comma = delimiter
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		i.logger.Error().Err(err).Msg("Failed to read csv header")
+		return responses.ImportSubscriptions{}, errors.Wrap(ErrInvalidField, "failed to read csv header")
+	}
+	columns, err := importColumns(header, mapping)
+	if err != nil {
+		i.logger.Error().Err(err).Msg("Invalid csv header")
+		return responses.ImportSubscriptions{}, err
+	}
+
+	response := responses.ImportSubscriptions{DryRun: req.DryRun, Errors: []responses.ImportRowError{}}
+	var subs []entities.Subscription
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		response.Total++
+		if response.Total > MaxImportRows {
+			i.logger.Error().Msg("Too many csv rows")
+			return responses.ImportSubscriptions{}, errors.Wrapf(ErrInvalidField, "csv has more than %d rows", MaxImportRows)
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) && errors.Is(parseErr.Err, csv.ErrFieldCount) {
+			response.Errors = append(response.Errors, responses.ImportRowError{Line: parseErr.StartLine, Error: "wrong number of fields"})
+			continue
+		}
+		if err != nil {
+			i.logger.Error().Err(err).Msg("Failed to read csv row")
+			return responses.ImportSubscriptions{}, errors.Wrap(ErrInvalidField, err.Error())
+		}
+
+		line, _ := reader.FieldPos(0)
+		sub, err := i.parseRow(record, columns)
+		if err != nil {
+			response.Errors = append(response.Errors, responses.ImportRowError{Line: line, Error: err.Error()})
+			continue
+		}
+		subs = append(subs, sub)
+	}
+
+	response.Valid = len(subs)
+	if req.DryRun || len(subs) == 0 {
+		return response, nil
+	}
+
+	if err := i.subRepo.InsertBatch(ctx, subs); err != nil {
+		i.logger.Error().Err(err).Msg("Failed to import subscriptions")
+		return responses.ImportSubscriptions{}, errors.Wrap(err, "failed to import subscriptions")
+	}
+	response.Imported = len(subs)
+
+	return response, nil
+}
+
+// parseRow fills a SubRequest from the columns of a record and builds the
+// subscription with the same checks as a single create.
+func (i *importSubsUseCase) parseRow(record []string, columns map[string]int) (entities.Subscription, error) {
+	value := func(field string) string {
+		if column, ok := columns[field]; ok {
+			return strings.TrimSpace(record[column])
+		}
+		return ""
+	}
+
+	req := requests.SubRequest{
+		ServiceName:   value("service_name"),
+		Currency:      value("currency"),
+		BillingPeriod: value("billing_period"),
+		UserID:        value("user_id"),
+		StartDate:     value("start_date"),
+		EndDate:       value("end_date"),
+		TrialStart:    value("trial_start_date"),
+		TrialEnd:      value("trial_end_date"),
+	}
+
+	price, err := strconv.Atoi(value("price"))
+	if err != nil {
+		return entities.Subscription{}, errors.Wrap(ErrInvalidField, "price must be an integer")
+	}
+	req.Price = price
+
+	return newSubscription(req, i.logger)
+}
+
+func parseDelimiter(value string) (rune, error) {
+	if value == "" {
+		return ',', nil
+	}
+
+	delimiter, size := utf8.DecodeRuneInString(value)
+	if size == 0 || size != len(value) || delimiter == utf8.RuneError ||
+		delimiter == '"' || delimiter == '\r' || delimiter == '\n' {
+		return 0, errors.Wrapf(ErrInvalidField, "invalid delimiter %q", value)
+	}
+
+	return delimiter, nil
+}
+
+// parseImportMapping reads "column:field" pairs into a column to field map.
+func parseImportMapping(pairs []string) (map[string]string, error) {
+	mapping := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		separator := strings.LastIndex(pair, ":")
+		if separator <= 0 {
+			return nil, errors.Wrapf(ErrInvalidField, "mapping %q must be column:field", pair)
+		}
+
+		field := pair[separator+1:]
+		if !slices.Contains(importFields, field) {
+			return nil, errors.Wrapf(ErrInvalidField, "unknown field %q in mapping", field)
+		}
+		mapping[pair[:separator]] = field
+	}
+
+	return mapping, nil
+}
+
+// importColumns returns the position of each field in the header. Columns
+// that are neither mapped nor named like a field are ignored.
+func importColumns(header []string, mapping map[string]string) (map[string]int, error) {
+	columns := make(map[string]int, len(importFields))
+	for position, column := range header {
+		if position == 0 {
+			// Spreadsheets often prepend a byte order mark.
+			column = strings.TrimPrefix(column, "\ufeff")
+		}
+		column = strings.TrimSpace(column)
+
+		field, ok := mapping[column]
+		if !ok {
+			if !slices.Contains(importFields, column) {
+				continue
+			}
+			field = column
+		}
+		if _, ok := columns[field]; ok {
+			return nil, errors.Wrapf(ErrInvalidField, "field %q is filled by more than one column", field)
+		}
+		columns[field] = position
+	}
+
+	for _, field := range requiredImportFields {
+		if _, ok := columns[field]; !ok {
+			return nil, errors.Wrapf(ErrInvalidField, "no column for required field %q", field)
+		}
+	}
+
+	return columns, nil
+}
@@ -0,0 +1,164 @@
+package usecases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"strings"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var mockImportSubsRepo *MockImportSubsRepository
+
+func initImportSubsTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockImportSubsRepo = NewMockImportSubsRepository(ctrl)
+	mockLogger = logger.NewMockLogger(t)
+}
+
+const importCSV = `service_name,price,user_id,start_date,end_date
+Yandex Plus,400,6060ffee-2bf1-4721-ae6f-7636e979a0cb,07-2025,12-2025
+Kinopoisk,abc,6060ffee-2bf1-4721-ae6f-7636e979a0cb,07-2025,
+Okko,300,6060ffee-2bf1-4721-ae6f-7636e979a0cb,2025-07-20,
+Ivi,250,not-a-uuid,07-2025,
+`
+
+func TestImportSubscriptions_Success_DryRun(t *testing.T) {
+	initImportSubsTestMocks(t)
+
+	useCase := NewImportSubsUseCase(mockImportSubsRepo, mockLogger)
+	response, err := useCase.ImportSubscriptions(context.Background(), strings.NewReader(importCSV), requests.ImportSubscriptions{DryRun: true})
+
+	assert.NoError(t, err)
+	assert.True(t, response.DryRun)
+	assert.Equal(t, 4, response.Total)
+	assert.Equal(t, 2, response.Valid)
+	assert.Equal(t, 0, response.Imported)
+	assert.Len(t, response.Errors, 2)
+	assert.Equal(t, 3, response.Errors[0].Line)
+	assert.Contains(t, response.Errors[0].Error, "price")
+	assert.Equal(t, 5, response.Errors[1].Line)
+	assert.Contains(t, response.Errors[1].Error, "user_id")
+}
+
+func TestImportSubscriptions_Success_InsertsValidRows(t *testing.T) {
+	initImportSubsTestMocks(t)
+	ctx := context.Background()
+
+	mockImportSubsRepo.EXPECT().InsertBatch(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, subs []entities.Subscription) error {
+			assert.Len(t, subs, 2)
+			assert.Equal(t, "2025-12-31", subs[0].EndDate.Format("2006-01-02"))
+			assert.Equal(t, "2025-07-20", subs[1].StartDate.Format("2006-01-02"))
+			return nil
+		})
+
+	useCase := NewImportSubsUseCase(mockImportSubsRepo, mockLogger)
+	response, err := useCase.ImportSubscriptions(ctx, strings.NewReader(importCSV), requests.ImportSubscriptions{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, response.Imported)
+	assert.Len(t, response.Errors, 2)
+}
+
+func TestImportSubscriptions_Success_OutOfRangeRowsAreReported(t *testing.T) {
+	initImportSubsTestMocks(t)
+	ctx := context.Background()
+	csv := "service_name,price,user_id,start_date\n" +
+		"Yandex Plus,400,6060ffee-2bf1-4721-ae6f-7636e979a0cb,07-2025\n" +
+		"Kinopoisk,-5,6060ffee-2bf1-4721-ae6f-7636e979a0cb,07-2025\n" +
+		strings.Repeat("a", maxServiceNameLength+1) + ",300,6060ffee-2bf1-4721-ae6f-7636e979a0cb,07-2025\n"
+
+	useCase := NewImportSubsUseCase(mockImportSubsRepo, mockLogger)
+	dryRun, err := useCase.ImportSubscriptions(ctx, strings.NewReader(csv), requests.ImportSubscriptions{DryRun: true})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, dryRun.Valid)
+	assert.Len(t, dryRun.Errors, 2)
+	assert.Equal(t, 3, dryRun.Errors[0].Line)
+	assert.Contains(t, dryRun.Errors[0].Error, "price")
+	assert.Equal(t, 4, dryRun.Errors[1].Line)
+	assert.Contains(t, dryRun.Errors[1].Error, "service_name")
+
+	mockImportSubsRepo.EXPECT().InsertBatch(ctx, gomock.Len(1)).Return(nil)
+
+	response, err := useCase.ImportSubscriptions(ctx, strings.NewReader(csv), requests.ImportSubscriptions{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, response.Imported)
+	assert.Equal(t, dryRun.Errors, response.Errors)
+}
+
+func TestImportSubscriptions_Success_DelimiterAndMapping(t *testing.T) {
+	initImportSubsTestMocks(t)
+	ctx := context.Background()
+	csv := "\ufeffСервис;Стоимость;user_id;Начало;Комментарий\n" +
+		"Yandex Plus;400;6060ffee-2bf1-4721-ae6f-7636e979a0cb;07-2025;корпоративная\n" +
+		"Okko;300;6060ffee-2bf1-4721-ae6f-7636e979a0cb\n"
+	req := requests.ImportSubscriptions{
+		Delimiter: ";",
+		Mapping:   []string{"Сервис:service_name", "Стоимость:price", "Начало:start_date"},
+	}
+
+	mockImportSubsRepo.EXPECT().InsertBatch(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, subs []entities.Subscription) error {
+			assert.Len(t, subs, 1)
+			assert.Equal(t, "Yandex Plus", subs[0].ServiceName)
+			assert.Equal(t, 400, subs[0].Price)
+			return nil
+		})
+
+	useCase := NewImportSubsUseCase(mockImportSubsRepo, mockLogger)
+	response, err := useCase.ImportSubscriptions(ctx, strings.NewReader(csv), req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, response.Imported)
+	assert.Equal(t, 3, response.Errors[0].Line)
+}
+
+func TestImportSubscriptions_Failure_MissingRequiredColumn(t *testing.T) {
+	initImportSubsTestMocks(t)
+	csv := "service_name,price,start_date\nYandex Plus,400,07-2025\n"
+
+	useCase := NewImportSubsUseCase(mockImportSubsRepo, mockLogger)
+	_, err := useCase.ImportSubscriptions(context.Background(), strings.NewReader(csv), requests.ImportSubscriptions{})
+
+	assert.ErrorIs(t, err, ErrInvalidField)
+	assert.Contains(t, err.Error(), "user_id")
+}
+
+func TestImportSubscriptions_Failure_UnknownMappedField(t *testing.T) {
+	initImportSubsTestMocks(t)
+
+	useCase := NewImportSubsUseCase(mockImportSubsRepo, mockLogger)
+	_, err := useCase.ImportSubscriptions(context.Background(), strings.NewReader(importCSV), requests.ImportSubscriptions{Mapping: []string{"Цена:cost"}})
+
+	assert.ErrorIs(t, err, ErrInvalidField)
+}
+
+func TestImportSubscriptions_Failure_InvalidDelimiter(t *testing.T) {
+	initImportSubsTestMocks(t)
+
+	useCase := NewImportSubsUseCase(mockImportSubsRepo, mockLogger)
+	_, err := useCase.ImportSubscriptions(context.Background(), strings.NewReader(importCSV), requests.ImportSubscriptions{Delimiter: ";;"})
+
+	assert.ErrorIs(t, err, ErrInvalidField)
+}
+
+func TestImportSubscriptions_Failure_InsertError(t *testing.T) {
+	initImportSubsTestMocks(t)
+	ctx := context.Background()
+
+	expectedErr := errors.New("database error")
+	mockImportSubsRepo.EXPECT().InsertBatch(ctx, gomock.Any()).Return(expectedErr)
+
+	useCase := NewImportSubsUseCase(mockImportSubsRepo, mockLogger)
+	_, err := useCase.ImportSubscriptions(ctx, strings.NewReader(importCSV), requests.ImportSubscriptions{})
+
+	assert.ErrorIs(t, err, expectedErr)
+}
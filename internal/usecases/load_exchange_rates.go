@@ -0,0 +1,85 @@
+package usecases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+)
+
+type loadExchangeRatesUseCase struct {
+	rateRepo LoadExchangeRatesRepository
+	logger   logger.Logger
+}
+
+type LoadExchangeRatesUseCase interface {
+	LoadExchangeRates(ctx context.Context, req []requests.ExchangeRate) (responses.LoadExchangeRates, error)
+}
+
+func NewLoadExchangeRatesUseCase(rateRepo LoadExchangeRatesRepository, logger logger.Logger) LoadExchangeRatesUseCase {
+	return &loadExchangeRatesUseCase{
+		rateRepo: rateRepo,
+		logger:   logger,
+	}
+}
+
+// LoadExchangeRates validates the rates and saves them, replacing previously loaded rates of the same pairs.
+func (l *loadExchangeRatesUseCase) LoadExchangeRates(ctx context.Context, req []requests.ExchangeRate) (responses.LoadExchangeRates, error) {
+	if len(req) == 0 {
+		l.logger.Error().Msg("No exchange rates to load")
+		return responses.LoadExchangeRates{}, errors.Wrap(ErrInvalidField, "no exchange rates to load")
+	}
+
+	rates := make([]entities.ExchangeRate, 0, len(req))
+	seen := make(map[currencyPair]struct{}, len(req))
+	for _, r := range req {
+		rate, err := l.parseRate(r)
+		if err != nil {
+			return responses.LoadExchangeRates{}, err
+		}
+
+		pair := currencyPair{rate.Base, rate.Quote}
+		if _, ok := seen[pair]; ok {
+			l.logger.Error().Msg("Duplicate exchange rate pair")
+			return responses.LoadExchangeRates{}, errors.Wrapf(ErrInvalidField, "duplicate rate for %s/%s", rate.Base, rate.Quote)
+		}
+		seen[pair] = struct{}{}
+
+		rates = append(rates, rate)
+	}
+
+	if err := l.rateRepo.Upsert(ctx, rates); err != nil {
+		l.logger.Error().Err(err).Msg("Failed to save exchange rates")
+		return responses.LoadExchangeRates{}, errors.Wrap(err, "failed to save exchange rates")
+	}
+
+	return responses.LoadExchangeRates{Loaded: len(rates)}, nil
+}
+
+func (l *loadExchangeRatesUseCase) parseRate(r requests.ExchangeRate) (entities.ExchangeRate, error) {
+	base, err := parseCurrency(r.Base)
+	if err != nil || r.Base == "" {
+		l.logger.Error().Msg("Invalid base currency")
+		return entities.ExchangeRate{}, errors.Wrapf(ErrInvalidCurrency, "invalid base currency %q", r.Base)
+	}
+
+	quote, err := parseCurrency(r.Quote)
+	if err != nil || r.Quote == "" {
+		l.logger.Error().Msg("Invalid quote currency")
+		return entities.ExchangeRate{}, errors.Wrapf(ErrInvalidCurrency, "invalid quote currency %q", r.Quote)
+	}
+
+	if base == quote {
+		l.logger.Error().Msg("Exchange rate currencies are equal")
+		return entities.ExchangeRate{}, errors.Wrap(ErrInvalidField, "base and quote currencies must differ")
+	}
+
+	if r.Rate <= 0 {
+		l.logger.Error().Msg("Exchange rate must be positive")
+		return entities.ExchangeRate{}, errors.Wrap(ErrInvalidField, "rate must be positive")
+	}
+
+	return entities.ExchangeRate{Base: base, Quote: quote, Rate: r.Rate}, nil
+}
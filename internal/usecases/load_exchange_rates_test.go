@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	mockLoadRatesRepo *MockLoadExchangeRatesRepository
+)
+
+func initLoadExchangeRatesTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockLoadRatesRepo = NewMockLoadExchangeRatesRepository(ctrl)
+	mockLogger = logger.NewMockLogger(t)
+}
+
+func TestLoadExchangeRates_Success(t *testing.T) {
+	initLoadExchangeRatesTestMocks(t)
+	ctx := context.Background()
+	req := []requests.ExchangeRate{
+		{Base: "USD", Quote: "RUB", Rate: 92.5},
+		{Base: "EUR", Quote: "RUB", Rate: 99.1},
+	}
+
+	mockLoadRatesRepo.EXPECT().Upsert(ctx, []entities.ExchangeRate{
+		{Base: "USD", Quote: "RUB", Rate: 92.5},
+		{Base: "EUR", Quote: "RUB", Rate: 99.1},
+	}).Return(nil)
+
+	useCase := NewLoadExchangeRatesUseCase(mockLoadRatesRepo, mockLogger)
+	response, err := useCase.LoadExchangeRates(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, response.Loaded)
+}
+
+func TestLoadExchangeRates_Failure_UnknownCurrency(t *testing.T) {
+	initLoadExchangeRatesTestMocks(t)
+	ctx := context.Background()
+	req := []requests.ExchangeRate{{Base: "ABC", Quote: "RUB", Rate: 1}}
+
+	useCase := NewLoadExchangeRatesUseCase(mockLoadRatesRepo, mockLogger)
+	_, err := useCase.LoadExchangeRates(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidCurrency)
+}
+
+func TestLoadExchangeRates_Failure_SameCurrency(t *testing.T) {
+	initLoadExchangeRatesTestMocks(t)
+	ctx := context.Background()
+	req := []requests.ExchangeRate{{Base: "RUB", Quote: "RUB", Rate: 1}}
+
+	useCase := NewLoadExchangeRatesUseCase(mockLoadRatesRepo, mockLogger)
+	_, err := useCase.LoadExchangeRates(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidField)
+}
+
+func TestLoadExchangeRates_Failure_DuplicatePair(t *testing.T) {
+	initLoadExchangeRatesTestMocks(t)
+	ctx := context.Background()
+	req := []requests.ExchangeRate{
+		{Base: "USD", Quote: "RUB", Rate: 92.5},
+		{Base: "USD", Quote: "RUB", Rate: 93},
+	}
+
+	useCase := NewLoadExchangeRatesUseCase(mockLoadRatesRepo, mockLogger)
+	_, err := useCase.LoadExchangeRates(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidField)
+}
+
+func TestLoadExchangeRates_Failure_DatabaseError(t *testing.T) {
+	initLoadExchangeRatesTestMocks(t)
+	ctx := context.Background()
+	req := []requests.ExchangeRate{{Base: "USD", Quote: "RUB", Rate: 92.5}}
+
+	expectedErr := errors.New("database error")
+	mockLoadRatesRepo.EXPECT().Upsert(ctx, gomock.Any()).Return(expectedErr)
+
+	useCase := NewLoadExchangeRatesUseCase(mockLoadRatesRepo, mockLogger)
+	_, err := useCase.LoadExchangeRates(ctx, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, expectedErr)
+}
@@ -15,6 +15,7 @@ import (
 	entities "subscription_service/internal/entities"
 	time "time"
 
+	uuid "github.com/google/uuid"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -81,17 +82,17 @@ func (m *MockDeleteSubRepository) EXPECT() *MockDeleteSubRepositoryMockRecorder
 }
 
 // Delete mocks base method.
-func (m *MockDeleteSubRepository) Delete(ctx context.Context, subID string) error {
+func (m *MockDeleteSubRepository) Delete(ctx context.Context, subID string, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, subID)
+	ret := m.ctrl.Call(m, "Delete", ctx, subID, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockDeleteSubRepositoryMockRecorder) Delete(ctx, subID any) *gomock.Call {
+func (mr *MockDeleteSubRepositoryMockRecorder) Delete(ctx, subID, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDeleteSubRepository)(nil).Delete), ctx, subID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDeleteSubRepository)(nil).Delete), ctx, subID, version)
 }
 
 // MockUpdateSubRepository is a mock of UpdateSubRepository interface.
@@ -118,6 +119,21 @@ func (m *MockUpdateSubRepository) EXPECT() *MockUpdateSubRepositoryMockRecorder
 	return m.recorder
 }
 
+// SelectByID mocks base method.
+func (m *MockUpdateSubRepository) SelectByID(ctx context.Context, subID string) (entities.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByID", ctx, subID)
+	ret0, _ := ret[0].(entities.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByID indicates an expected call of SelectByID.
+func (mr *MockUpdateSubRepositoryMockRecorder) SelectByID(ctx, subID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByID", reflect.TypeOf((*MockUpdateSubRepository)(nil).SelectByID), ctx, subID)
+}
+
 // Update mocks base method.
 func (m *MockUpdateSubRepository) Update(ctx context.Context, sub *entities.Subscription) error {
 	m.ctrl.T.Helper()
@@ -132,6 +148,60 @@ func (mr *MockUpdateSubRepositoryMockRecorder) Update(ctx, sub any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUpdateSubRepository)(nil).Update), ctx, sub)
 }
 
+// MockPatchSubRepository is a mock of PatchSubRepository interface.
+type MockPatchSubRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPatchSubRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPatchSubRepositoryMockRecorder is the mock recorder for MockPatchSubRepository.
+type MockPatchSubRepositoryMockRecorder struct {
+	mock *MockPatchSubRepository
+}
+
+// NewMockPatchSubRepository creates a new mock instance.
+func NewMockPatchSubRepository(ctrl *gomock.Controller) *MockPatchSubRepository {
+	mock := &MockPatchSubRepository{ctrl: ctrl}
+	mock.recorder = &MockPatchSubRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPatchSubRepository) EXPECT() *MockPatchSubRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Patch mocks base method.
+func (m *MockPatchSubRepository) Patch(ctx context.Context, subID string, version int, changes entities.SubscriptionChanges) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, subID, version, changes)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockPatchSubRepositoryMockRecorder) Patch(ctx, subID, version, changes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockPatchSubRepository)(nil).Patch), ctx, subID, version, changes)
+}
+
+// SelectByID mocks base method.
+func (m *MockPatchSubRepository) SelectByID(ctx context.Context, subID string) (entities.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByID", ctx, subID)
+	ret0, _ := ret[0].(entities.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByID indicates an expected call of SelectByID.
+func (mr *MockPatchSubRepositoryMockRecorder) SelectByID(ctx, subID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByID", reflect.TypeOf((*MockPatchSubRepository)(nil).SelectByID), ctx, subID)
+}
+
 // MockGetSubRepository is a mock of GetSubRepository interface.
 type MockGetSubRepository struct {
 	ctrl     *gomock.Controller
@@ -195,19 +265,148 @@ func (m *MockGetAllSubsRepository) EXPECT() *MockGetAllSubsRepositoryMockRecorde
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockGetAllSubsRepository) Count(ctx context.Context, filter entities.SubscriptionFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockGetAllSubsRepositoryMockRecorder) Count(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockGetAllSubsRepository)(nil).Count), ctx, filter)
+}
+
 // SelectAll mocks base method.
-func (m *MockGetAllSubsRepository) SelectAll(ctx context.Context, limit, offset int) ([]entities.Subscription, error) {
+func (m *MockGetAllSubsRepository) SelectAll(ctx context.Context, filter entities.SubscriptionFilter, sort entities.SubscriptionSort, page entities.Page) ([]entities.Subscription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SelectAll", ctx, limit, offset)
+	ret := m.ctrl.Call(m, "SelectAll", ctx, filter, sort, page)
 	ret0, _ := ret[0].([]entities.Subscription)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SelectAll indicates an expected call of SelectAll.
-func (mr *MockGetAllSubsRepositoryMockRecorder) SelectAll(ctx, limit, offset any) *gomock.Call {
+func (mr *MockGetAllSubsRepositoryMockRecorder) SelectAll(ctx, filter, sort, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAll", reflect.TypeOf((*MockGetAllSubsRepository)(nil).SelectAll), ctx, filter, sort, page)
+}
+
+// MockExportSubsRepository is a mock of ExportSubsRepository interface.
+type MockExportSubsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportSubsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockExportSubsRepositoryMockRecorder is the mock recorder for MockExportSubsRepository.
+type MockExportSubsRepositoryMockRecorder struct {
+	mock *MockExportSubsRepository
+}
+
+// NewMockExportSubsRepository creates a new mock instance.
+func NewMockExportSubsRepository(ctrl *gomock.Controller) *MockExportSubsRepository {
+	mock := &MockExportSubsRepository{ctrl: ctrl}
+	mock.recorder = &MockExportSubsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportSubsRepository) EXPECT() *MockExportSubsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Stream mocks base method.
+func (m *MockExportSubsRepository) Stream(ctx context.Context, filter entities.SubscriptionFilter, sort entities.SubscriptionSort, fn func(entities.Subscription) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", ctx, filter, sort, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockExportSubsRepositoryMockRecorder) Stream(ctx, filter, sort, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockExportSubsRepository)(nil).Stream), ctx, filter, sort, fn)
+}
+
+// MockUserCalendarRepository is a mock of UserCalendarRepository interface.
+type MockUserCalendarRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserCalendarRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserCalendarRepositoryMockRecorder is the mock recorder for MockUserCalendarRepository.
+type MockUserCalendarRepositoryMockRecorder struct {
+	mock *MockUserCalendarRepository
+}
+
+// NewMockUserCalendarRepository creates a new mock instance.
+func NewMockUserCalendarRepository(ctrl *gomock.Controller) *MockUserCalendarRepository {
+	mock := &MockUserCalendarRepository{ctrl: ctrl}
+	mock.recorder = &MockUserCalendarRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserCalendarRepository) EXPECT() *MockUserCalendarRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Stream mocks base method.
+func (m *MockUserCalendarRepository) Stream(ctx context.Context, filter entities.SubscriptionFilter, sort entities.SubscriptionSort, fn func(entities.Subscription) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", ctx, filter, sort, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockUserCalendarRepositoryMockRecorder) Stream(ctx, filter, sort, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockUserCalendarRepository)(nil).Stream), ctx, filter, sort, fn)
+}
+
+// MockStatementSubsRepository is a mock of StatementSubsRepository interface.
+type MockStatementSubsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatementSubsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockStatementSubsRepositoryMockRecorder is the mock recorder for MockStatementSubsRepository.
+type MockStatementSubsRepositoryMockRecorder struct {
+	mock *MockStatementSubsRepository
+}
+
+// NewMockStatementSubsRepository creates a new mock instance.
+func NewMockStatementSubsRepository(ctrl *gomock.Controller) *MockStatementSubsRepository {
+	mock := &MockStatementSubsRepository{ctrl: ctrl}
+	mock.recorder = &MockStatementSubsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatementSubsRepository) EXPECT() *MockStatementSubsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Stream mocks base method.
+func (m *MockStatementSubsRepository) Stream(ctx context.Context, filter entities.SubscriptionFilter, sort entities.SubscriptionSort, fn func(entities.Subscription) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", ctx, filter, sort, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockStatementSubsRepositoryMockRecorder) Stream(ctx, filter, sort, fn any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAll", reflect.TypeOf((*MockGetAllSubsRepository)(nil).SelectAll), ctx, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockStatementSubsRepository)(nil).Stream), ctx, filter, sort, fn)
 }
 
 // MockCalculateTotalCostRepository is a mock of CalculateTotalCostRepository interface.
@@ -234,17 +433,855 @@ func (m *MockCalculateTotalCostRepository) EXPECT() *MockCalculateTotalCostRepos
 	return m.recorder
 }
 
-// CalculateTotalCost mocks base method.
-func (m *MockCalculateTotalCostRepository) CalculateTotalCost(ctx context.Context, startPeriod, endPeriod time.Time, userID, serviceName *string) (int, error) {
+// SelectByPeriod mocks base method.
+func (m *MockCalculateTotalCostRepository) SelectByPeriod(ctx context.Context, startPeriod, endPeriod time.Time, userID, serviceName *string) ([]entities.Subscription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CalculateTotalCost", ctx, startPeriod, endPeriod, userID, serviceName)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "SelectByPeriod", ctx, startPeriod, endPeriod, userID, serviceName)
+	ret0, _ := ret[0].([]entities.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByPeriod indicates an expected call of SelectByPeriod.
+func (mr *MockCalculateTotalCostRepositoryMockRecorder) SelectByPeriod(ctx, startPeriod, endPeriod, userID, serviceName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByPeriod", reflect.TypeOf((*MockCalculateTotalCostRepository)(nil).SelectByPeriod), ctx, startPeriod, endPeriod, userID, serviceName)
+}
+
+// MockIdempotencyRepository is a mock of IdempotencyRepository interface.
+type MockIdempotencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIdempotencyRepositoryMockRecorder is the mock recorder for MockIdempotencyRepository.
+type MockIdempotencyRepositoryMockRecorder struct {
+	mock *MockIdempotencyRepository
+}
+
+// NewMockIdempotencyRepository creates a new mock instance.
+func NewMockIdempotencyRepository(ctrl *gomock.Controller) *MockIdempotencyRepository {
+	mock := &MockIdempotencyRepository{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyRepository) EXPECT() *MockIdempotencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockIdempotencyRepository) Insert(ctx context.Context, record entities.IdempotencyRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockIdempotencyRepositoryMockRecorder) Insert(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockIdempotencyRepository)(nil).Insert), ctx, record)
+}
+
+// SelectByKey mocks base method.
+func (m *MockIdempotencyRepository) SelectByKey(ctx context.Context, key string) (entities.IdempotencyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByKey", ctx, key)
+	ret0, _ := ret[0].(entities.IdempotencyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByKey indicates an expected call of SelectByKey.
+func (mr *MockIdempotencyRepositoryMockRecorder) SelectByKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByKey", reflect.TypeOf((*MockIdempotencyRepository)(nil).SelectByKey), ctx, key)
+}
+
+// MockSubPriceHistoryRepository is a mock of SubPriceHistoryRepository interface.
+type MockSubPriceHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubPriceHistoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSubPriceHistoryRepositoryMockRecorder is the mock recorder for MockSubPriceHistoryRepository.
+type MockSubPriceHistoryRepositoryMockRecorder struct {
+	mock *MockSubPriceHistoryRepository
+}
+
+// NewMockSubPriceHistoryRepository creates a new mock instance.
+func NewMockSubPriceHistoryRepository(ctrl *gomock.Controller) *MockSubPriceHistoryRepository {
+	mock := &MockSubPriceHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockSubPriceHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubPriceHistoryRepository) EXPECT() *MockSubPriceHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectBySubscriptionIDs mocks base method.
+func (m *MockSubPriceHistoryRepository) SelectBySubscriptionIDs(ctx context.Context, subIDs []uuid.UUID) ([]entities.SubscriptionPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectBySubscriptionIDs", ctx, subIDs)
+	ret0, _ := ret[0].([]entities.SubscriptionPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectBySubscriptionIDs indicates an expected call of SelectBySubscriptionIDs.
+func (mr *MockSubPriceHistoryRepositoryMockRecorder) SelectBySubscriptionIDs(ctx, subIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectBySubscriptionIDs", reflect.TypeOf((*MockSubPriceHistoryRepository)(nil).SelectBySubscriptionIDs), ctx, subIDs)
+}
+
+// MockAddSubPriceRepository is a mock of AddSubPriceRepository interface.
+type MockAddSubPriceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddSubPriceRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAddSubPriceRepositoryMockRecorder is the mock recorder for MockAddSubPriceRepository.
+type MockAddSubPriceRepositoryMockRecorder struct {
+	mock *MockAddSubPriceRepository
+}
+
+// NewMockAddSubPriceRepository creates a new mock instance.
+func NewMockAddSubPriceRepository(ctrl *gomock.Controller) *MockAddSubPriceRepository {
+	mock := &MockAddSubPriceRepository{ctrl: ctrl}
+	mock.recorder = &MockAddSubPriceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddSubPriceRepository) EXPECT() *MockAddSubPriceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddPrice mocks base method.
+func (m *MockAddSubPriceRepository) AddPrice(ctx context.Context, price entities.SubscriptionPrice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPrice", ctx, price)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPrice indicates an expected call of AddPrice.
+func (mr *MockAddSubPriceRepositoryMockRecorder) AddPrice(ctx, price any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPrice", reflect.TypeOf((*MockAddSubPriceRepository)(nil).AddPrice), ctx, price)
+}
+
+// SelectByID mocks base method.
+func (m *MockAddSubPriceRepository) SelectByID(ctx context.Context, subID string) (entities.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByID", ctx, subID)
+	ret0, _ := ret[0].(entities.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByID indicates an expected call of SelectByID.
+func (mr *MockAddSubPriceRepositoryMockRecorder) SelectByID(ctx, subID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByID", reflect.TypeOf((*MockAddSubPriceRepository)(nil).SelectByID), ctx, subID)
+}
+
+// MockExchangeRatesRepository is a mock of ExchangeRatesRepository interface.
+type MockExchangeRatesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRatesRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockExchangeRatesRepositoryMockRecorder is the mock recorder for MockExchangeRatesRepository.
+type MockExchangeRatesRepositoryMockRecorder struct {
+	mock *MockExchangeRatesRepository
+}
+
+// NewMockExchangeRatesRepository creates a new mock instance.
+func NewMockExchangeRatesRepository(ctrl *gomock.Controller) *MockExchangeRatesRepository {
+	mock := &MockExchangeRatesRepository{ctrl: ctrl}
+	mock.recorder = &MockExchangeRatesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRatesRepository) EXPECT() *MockExchangeRatesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectAll mocks base method.
+func (m *MockExchangeRatesRepository) SelectAll(ctx context.Context) ([]entities.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAll", ctx)
+	ret0, _ := ret[0].([]entities.ExchangeRate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CalculateTotalCost indicates an expected call of CalculateTotalCost.
-func (mr *MockCalculateTotalCostRepositoryMockRecorder) CalculateTotalCost(ctx, startPeriod, endPeriod, userID, serviceName any) *gomock.Call {
+// SelectAll indicates an expected call of SelectAll.
+func (mr *MockExchangeRatesRepositoryMockRecorder) SelectAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAll", reflect.TypeOf((*MockExchangeRatesRepository)(nil).SelectAll), ctx)
+}
+
+// MockLoadExchangeRatesRepository is a mock of LoadExchangeRatesRepository interface.
+type MockLoadExchangeRatesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoadExchangeRatesRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLoadExchangeRatesRepositoryMockRecorder is the mock recorder for MockLoadExchangeRatesRepository.
+type MockLoadExchangeRatesRepositoryMockRecorder struct {
+	mock *MockLoadExchangeRatesRepository
+}
+
+// NewMockLoadExchangeRatesRepository creates a new mock instance.
+func NewMockLoadExchangeRatesRepository(ctrl *gomock.Controller) *MockLoadExchangeRatesRepository {
+	mock := &MockLoadExchangeRatesRepository{ctrl: ctrl}
+	mock.recorder = &MockLoadExchangeRatesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoadExchangeRatesRepository) EXPECT() *MockLoadExchangeRatesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Upsert mocks base method.
+func (m *MockLoadExchangeRatesRepository) Upsert(ctx context.Context, rates []entities.ExchangeRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, rates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockLoadExchangeRatesRepositoryMockRecorder) Upsert(ctx, rates any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockLoadExchangeRatesRepository)(nil).Upsert), ctx, rates)
+}
+
+// MockEndingTrialsRepository is a mock of EndingTrialsRepository interface.
+type MockEndingTrialsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEndingTrialsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEndingTrialsRepositoryMockRecorder is the mock recorder for MockEndingTrialsRepository.
+type MockEndingTrialsRepositoryMockRecorder struct {
+	mock *MockEndingTrialsRepository
+}
+
+// NewMockEndingTrialsRepository creates a new mock instance.
+func NewMockEndingTrialsRepository(ctrl *gomock.Controller) *MockEndingTrialsRepository {
+	mock := &MockEndingTrialsRepository{ctrl: ctrl}
+	mock.recorder = &MockEndingTrialsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEndingTrialsRepository) EXPECT() *MockEndingTrialsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectTrialsEnding mocks base method.
+func (m *MockEndingTrialsRepository) SelectTrialsEnding(ctx context.Context, from, to time.Time) ([]entities.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectTrialsEnding", ctx, from, to)
+	ret0, _ := ret[0].([]entities.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectTrialsEnding indicates an expected call of SelectTrialsEnding.
+func (mr *MockEndingTrialsRepositoryMockRecorder) SelectTrialsEnding(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectTrialsEnding", reflect.TypeOf((*MockEndingTrialsRepository)(nil).SelectTrialsEnding), ctx, from, to)
+}
+
+// MockSubPausesRepository is a mock of SubPausesRepository interface.
+type MockSubPausesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubPausesRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSubPausesRepositoryMockRecorder is the mock recorder for MockSubPausesRepository.
+type MockSubPausesRepositoryMockRecorder struct {
+	mock *MockSubPausesRepository
+}
+
+// NewMockSubPausesRepository creates a new mock instance.
+func NewMockSubPausesRepository(ctrl *gomock.Controller) *MockSubPausesRepository {
+	mock := &MockSubPausesRepository{ctrl: ctrl}
+	mock.recorder = &MockSubPausesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubPausesRepository) EXPECT() *MockSubPausesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectBySubscriptionIDs mocks base method.
+func (m *MockSubPausesRepository) SelectBySubscriptionIDs(ctx context.Context, subIDs []uuid.UUID) ([]entities.SubscriptionPause, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectBySubscriptionIDs", ctx, subIDs)
+	ret0, _ := ret[0].([]entities.SubscriptionPause)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectBySubscriptionIDs indicates an expected call of SelectBySubscriptionIDs.
+func (mr *MockSubPausesRepositoryMockRecorder) SelectBySubscriptionIDs(ctx, subIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectBySubscriptionIDs", reflect.TypeOf((*MockSubPausesRepository)(nil).SelectBySubscriptionIDs), ctx, subIDs)
+}
+
+// MockPauseSubRepository is a mock of PauseSubRepository interface.
+type MockPauseSubRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPauseSubRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPauseSubRepositoryMockRecorder is the mock recorder for MockPauseSubRepository.
+type MockPauseSubRepositoryMockRecorder struct {
+	mock *MockPauseSubRepository
+}
+
+// NewMockPauseSubRepository creates a new mock instance.
+func NewMockPauseSubRepository(ctrl *gomock.Controller) *MockPauseSubRepository {
+	mock := &MockPauseSubRepository{ctrl: ctrl}
+	mock.recorder = &MockPauseSubRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPauseSubRepository) EXPECT() *MockPauseSubRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Pause mocks base method.
+func (m *MockPauseSubRepository) Pause(ctx context.Context, pause entities.SubscriptionPause) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", ctx, pause)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockPauseSubRepositoryMockRecorder) Pause(ctx, pause any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockPauseSubRepository)(nil).Pause), ctx, pause)
+}
+
+// SelectByID mocks base method.
+func (m *MockPauseSubRepository) SelectByID(ctx context.Context, subID string) (entities.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByID", ctx, subID)
+	ret0, _ := ret[0].(entities.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByID indicates an expected call of SelectByID.
+func (mr *MockPauseSubRepositoryMockRecorder) SelectByID(ctx, subID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByID", reflect.TypeOf((*MockPauseSubRepository)(nil).SelectByID), ctx, subID)
+}
+
+// MockResumeSubRepository is a mock of ResumeSubRepository interface.
+type MockResumeSubRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockResumeSubRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockResumeSubRepositoryMockRecorder is the mock recorder for MockResumeSubRepository.
+type MockResumeSubRepositoryMockRecorder struct {
+	mock *MockResumeSubRepository
+}
+
+// NewMockResumeSubRepository creates a new mock instance.
+func NewMockResumeSubRepository(ctrl *gomock.Controller) *MockResumeSubRepository {
+	mock := &MockResumeSubRepository{ctrl: ctrl}
+	mock.recorder = &MockResumeSubRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResumeSubRepository) EXPECT() *MockResumeSubRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Resume mocks base method.
+func (m *MockResumeSubRepository) Resume(ctx context.Context, subID uuid.UUID, resumedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", ctx, subID, resumedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockResumeSubRepositoryMockRecorder) Resume(ctx, subID, resumedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockResumeSubRepository)(nil).Resume), ctx, subID, resumedAt)
+}
+
+// SelectByID mocks base method.
+func (m *MockResumeSubRepository) SelectByID(ctx context.Context, subID string) (entities.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByID", ctx, subID)
+	ret0, _ := ret[0].(entities.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByID indicates an expected call of SelectByID.
+func (mr *MockResumeSubRepositoryMockRecorder) SelectByID(ctx, subID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByID", reflect.TypeOf((*MockResumeSubRepository)(nil).SelectByID), ctx, subID)
+}
+
+// MockRestoreSubRepository is a mock of RestoreSubRepository interface.
+type MockRestoreSubRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRestoreSubRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRestoreSubRepositoryMockRecorder is the mock recorder for MockRestoreSubRepository.
+type MockRestoreSubRepositoryMockRecorder struct {
+	mock *MockRestoreSubRepository
+}
+
+// NewMockRestoreSubRepository creates a new mock instance.
+func NewMockRestoreSubRepository(ctrl *gomock.Controller) *MockRestoreSubRepository {
+	mock := &MockRestoreSubRepository{ctrl: ctrl}
+	mock.recorder = &MockRestoreSubRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRestoreSubRepository) EXPECT() *MockRestoreSubRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Restore mocks base method.
+func (m *MockRestoreSubRepository) Restore(ctx context.Context, subID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, subID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockRestoreSubRepositoryMockRecorder) Restore(ctx, subID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockRestoreSubRepository)(nil).Restore), ctx, subID)
+}
+
+// SelectByID mocks base method.
+func (m *MockRestoreSubRepository) SelectByID(ctx context.Context, subID string) (entities.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByID", ctx, subID)
+	ret0, _ := ret[0].(entities.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByID indicates an expected call of SelectByID.
+func (mr *MockRestoreSubRepositoryMockRecorder) SelectByID(ctx, subID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByID", reflect.TypeOf((*MockRestoreSubRepository)(nil).SelectByID), ctx, subID)
+}
+
+// MockPurgeSubsRepository is a mock of PurgeSubsRepository interface.
+type MockPurgeSubsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPurgeSubsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPurgeSubsRepositoryMockRecorder is the mock recorder for MockPurgeSubsRepository.
+type MockPurgeSubsRepositoryMockRecorder struct {
+	mock *MockPurgeSubsRepository
+}
+
+// NewMockPurgeSubsRepository creates a new mock instance.
+func NewMockPurgeSubsRepository(ctrl *gomock.Controller) *MockPurgeSubsRepository {
+	mock := &MockPurgeSubsRepository{ctrl: ctrl}
+	mock.recorder = &MockPurgeSubsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPurgeSubsRepository) EXPECT() *MockPurgeSubsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Purge mocks base method.
+func (m *MockPurgeSubsRepository) Purge(ctx context.Context, deletedBefore time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, deletedBefore)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockPurgeSubsRepositoryMockRecorder) Purge(ctx, deletedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockPurgeSubsRepository)(nil).Purge), ctx, deletedBefore)
+}
+
+// MockAuditLogRepository is a mock of AuditLogRepository interface.
+type MockAuditLogRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditLogRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuditLogRepositoryMockRecorder is the mock recorder for MockAuditLogRepository.
+type MockAuditLogRepositoryMockRecorder struct {
+	mock *MockAuditLogRepository
+}
+
+// NewMockAuditLogRepository creates a new mock instance.
+func NewMockAuditLogRepository(ctrl *gomock.Controller) *MockAuditLogRepository {
+	mock := &MockAuditLogRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditLogRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogRepository) EXPECT() *MockAuditLogRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectAll mocks base method.
+func (m *MockAuditLogRepository) SelectAll(ctx context.Context, filter entities.AuditFilter) ([]entities.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectAll", ctx, filter)
+	ret0, _ := ret[0].([]entities.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectAll indicates an expected call of SelectAll.
+func (mr *MockAuditLogRepositoryMockRecorder) SelectAll(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectAll", reflect.TypeOf((*MockAuditLogRepository)(nil).SelectAll), ctx, filter)
+}
+
+// MockTxManager is a mock of TxManager interface.
+type MockTxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockTxManagerMockRecorder is the mock recorder for MockTxManager.
+type MockTxManagerMockRecorder struct {
+	mock *MockTxManager
+}
+
+// NewMockTxManager creates a new mock instance.
+func NewMockTxManager(ctrl *gomock.Controller) *MockTxManager {
+	mock := &MockTxManager{ctrl: ctrl}
+	mock.recorder = &MockTxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTxManager) EXPECT() *MockTxManagerMockRecorder {
+	return m.recorder
+}
+
+// WithinTx mocks base method.
+func (m *MockTxManager) WithinTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithinTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithinTx indicates an expected call of WithinTx.
+func (mr *MockTxManagerMockRecorder) WithinTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithinTx", reflect.TypeOf((*MockTxManager)(nil).WithinTx), ctx, fn)
+}
+
+// MockBatchSubRepository is a mock of BatchSubRepository interface.
+type MockBatchSubRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchSubRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBatchSubRepositoryMockRecorder is the mock recorder for MockBatchSubRepository.
+type MockBatchSubRepositoryMockRecorder struct {
+	mock *MockBatchSubRepository
+}
+
+// NewMockBatchSubRepository creates a new mock instance.
+func NewMockBatchSubRepository(ctrl *gomock.Controller) *MockBatchSubRepository {
+	mock := &MockBatchSubRepository{ctrl: ctrl}
+	mock.recorder = &MockBatchSubRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchSubRepository) EXPECT() *MockBatchSubRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockBatchSubRepository) Delete(ctx context.Context, subID string, version int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, subID, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBatchSubRepositoryMockRecorder) Delete(ctx, subID, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBatchSubRepository)(nil).Delete), ctx, subID, version)
+}
+
+// Insert mocks base method.
+func (m *MockBatchSubRepository) Insert(ctx context.Context, sub *entities.Subscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, sub)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockBatchSubRepositoryMockRecorder) Insert(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockBatchSubRepository)(nil).Insert), ctx, sub)
+}
+
+// InsertBatch mocks base method.
+func (m *MockBatchSubRepository) InsertBatch(ctx context.Context, subs []entities.Subscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertBatch", ctx, subs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertBatch indicates an expected call of InsertBatch.
+func (mr *MockBatchSubRepositoryMockRecorder) InsertBatch(ctx, subs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertBatch", reflect.TypeOf((*MockBatchSubRepository)(nil).InsertBatch), ctx, subs)
+}
+
+// SelectByID mocks base method.
+func (m *MockBatchSubRepository) SelectByID(ctx context.Context, subID string) (entities.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByID", ctx, subID)
+	ret0, _ := ret[0].(entities.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByID indicates an expected call of SelectByID.
+func (mr *MockBatchSubRepositoryMockRecorder) SelectByID(ctx, subID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByID", reflect.TypeOf((*MockBatchSubRepository)(nil).SelectByID), ctx, subID)
+}
+
+// Update mocks base method.
+func (m *MockBatchSubRepository) Update(ctx context.Context, sub *entities.Subscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, sub)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBatchSubRepositoryMockRecorder) Update(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBatchSubRepository)(nil).Update), ctx, sub)
+}
+
+// MockImportSubsRepository is a mock of ImportSubsRepository interface.
+type MockImportSubsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportSubsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockImportSubsRepositoryMockRecorder is the mock recorder for MockImportSubsRepository.
+type MockImportSubsRepositoryMockRecorder struct {
+	mock *MockImportSubsRepository
+}
+
+// NewMockImportSubsRepository creates a new mock instance.
+func NewMockImportSubsRepository(ctrl *gomock.Controller) *MockImportSubsRepository {
+	mock := &MockImportSubsRepository{ctrl: ctrl}
+	mock.recorder = &MockImportSubsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportSubsRepository) EXPECT() *MockImportSubsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// InsertBatch mocks base method.
+func (m *MockImportSubsRepository) InsertBatch(ctx context.Context, subs []entities.Subscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertBatch", ctx, subs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertBatch indicates an expected call of InsertBatch.
+func (mr *MockImportSubsRepositoryMockRecorder) InsertBatch(ctx, subs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertBatch", reflect.TypeOf((*MockImportSubsRepository)(nil).InsertBatch), ctx, subs)
+}
+
+// MockImportStatementRepository is a mock of ImportStatementRepository interface.
+type MockImportStatementRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportStatementRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockImportStatementRepositoryMockRecorder is the mock recorder for MockImportStatementRepository.
+type MockImportStatementRepositoryMockRecorder struct {
+	mock *MockImportStatementRepository
+}
+
+// NewMockImportStatementRepository creates a new mock instance.
+func NewMockImportStatementRepository(ctrl *gomock.Controller) *MockImportStatementRepository {
+	mock := &MockImportStatementRepository{ctrl: ctrl}
+	mock.recorder = &MockImportStatementRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportStatementRepository) EXPECT() *MockImportStatementRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Upsert mocks base method.
+func (m *MockImportStatementRepository) Upsert(ctx context.Context, suggestions []entities.SubscriptionSuggestion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, suggestions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockImportStatementRepositoryMockRecorder) Upsert(ctx, suggestions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockImportStatementRepository)(nil).Upsert), ctx, suggestions)
+}
+
+// MockGetSuggestionsRepository is a mock of GetSuggestionsRepository interface.
+type MockGetSuggestionsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetSuggestionsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGetSuggestionsRepositoryMockRecorder is the mock recorder for MockGetSuggestionsRepository.
+type MockGetSuggestionsRepositoryMockRecorder struct {
+	mock *MockGetSuggestionsRepository
+}
+
+// NewMockGetSuggestionsRepository creates a new mock instance.
+func NewMockGetSuggestionsRepository(ctrl *gomock.Controller) *MockGetSuggestionsRepository {
+	mock := &MockGetSuggestionsRepository{ctrl: ctrl}
+	mock.recorder = &MockGetSuggestionsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetSuggestionsRepository) EXPECT() *MockGetSuggestionsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectByUser mocks base method.
+func (m *MockGetSuggestionsRepository) SelectByUser(ctx context.Context, userID uuid.UUID) ([]entities.SubscriptionSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByUser", ctx, userID)
+	ret0, _ := ret[0].([]entities.SubscriptionSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByUser indicates an expected call of SelectByUser.
+func (mr *MockGetSuggestionsRepositoryMockRecorder) SelectByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByUser", reflect.TypeOf((*MockGetSuggestionsRepository)(nil).SelectByUser), ctx, userID)
+}
+
+// MockSuggestionStatusRepository is a mock of SuggestionStatusRepository interface.
+type MockSuggestionStatusRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSuggestionStatusRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSuggestionStatusRepositoryMockRecorder is the mock recorder for MockSuggestionStatusRepository.
+type MockSuggestionStatusRepositoryMockRecorder struct {
+	mock *MockSuggestionStatusRepository
+}
+
+// NewMockSuggestionStatusRepository creates a new mock instance.
+func NewMockSuggestionStatusRepository(ctrl *gomock.Controller) *MockSuggestionStatusRepository {
+	mock := &MockSuggestionStatusRepository{ctrl: ctrl}
+	mock.recorder = &MockSuggestionStatusRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSuggestionStatusRepository) EXPECT() *MockSuggestionStatusRepositoryMockRecorder {
+	return m.recorder
+}
+
+// SelectByID mocks base method.
+func (m *MockSuggestionStatusRepository) SelectByID(ctx context.Context, userID, suggestionID uuid.UUID) (entities.SubscriptionSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectByID", ctx, userID, suggestionID)
+	ret0, _ := ret[0].(entities.SubscriptionSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByID indicates an expected call of SelectByID.
+func (mr *MockSuggestionStatusRepositoryMockRecorder) SelectByID(ctx, userID, suggestionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByID", reflect.TypeOf((*MockSuggestionStatusRepository)(nil).SelectByID), ctx, userID, suggestionID)
+}
+
+// SetStatus mocks base method.
+func (m *MockSuggestionStatusRepository) SetStatus(ctx context.Context, suggestionID uuid.UUID, status string, subID *uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStatus", ctx, suggestionID, status, subID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStatus indicates an expected call of SetStatus.
+func (mr *MockSuggestionStatusRepositoryMockRecorder) SetStatus(ctx, suggestionID, status, subID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculateTotalCost", reflect.TypeOf((*MockCalculateTotalCostRepository)(nil).CalculateTotalCost), ctx, startPeriod, endPeriod, userID, serviceName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStatus", reflect.TypeOf((*MockSuggestionStatusRepository)(nil).SetStatus), ctx, suggestionID, status, subID)
 }
@@ -0,0 +1,247 @@
+package usecases
+
+import (
+	"context"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"subscription_service/pkg/logger"
+)
+
+type patchSubUseCase struct {
+	subRepo PatchSubRepository
+	logger  logger.Logger
+}
+
+type PatchSubUseCase interface {
+	PatchSubscription(ctx context.Context, subID string, version int, req requests.SubPatchRequest) (responses.SubResponse, error)
+}
+
+func NewPatchSubUseCase(subRepo PatchSubRepository, logger logger.Logger) PatchSubUseCase {
+	return &patchSubUseCase{
+		subRepo: subRepo,
+		logger:  logger,
+	}
+}
+
+// PatchSubscription applies a JSON Merge Patch to the subscription: absent
+// fields are kept, null clears end_date or the trial, and only changed columns are written.
+func (p *patchSubUseCase) PatchSubscription(ctx context.Context, subID string, version int, req requests.SubPatchRequest) (responses.SubResponse, error) {
+	if _, err := uuid.Parse(subID); err != nil {
+		p.logger.Error().Err(err).Msg("Invalid sub_id format")
+		return responses.SubResponse{}, errors.Wrap(ErrInvalidUUID, "failed to parse sub_id")
+	}
+
+	sub, err := p.subRepo.SelectByID(ctx, subID)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("Failed to get subscription")
+		return responses.SubResponse{}, errors.Wrap(err, "failed to get subscription")
+	}
+
+	if sub.Version != version {
+		p.logger.Error().Msg("Subscription version mismatch")
+		return responses.SubResponse{}, errors.Wrap(ErrVersionMismatch, "subscription was modified")
+	}
+
+	changes, err := p.applyPatch(&sub, req)
+	if err != nil {
+		return responses.SubResponse{}, err
+	}
+
+	if sub.EndDate != nil && sub.EndDate.Before(sub.StartDate) {
+		p.logger.Error().Msg("end_date is before start_date")
+		return responses.SubResponse{}, errors.Wrap(ErrInvalidPeriod, "end_date is before start_date")
+	}
+
+	if err := validateTrial(sub); err != nil {
+		p.logger.Error().Err(err).Msg("Invalid trial")
+		return responses.SubResponse{}, err
+	}
+
+	if changes.IsEmpty() {
+		return toSubResponse(ctx, sub), nil
+	}
+
+	sub.Version, err = p.subRepo.Patch(ctx, subID, version, changes)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("Failed to patch subscription")
+		return responses.SubResponse{}, errors.Wrap(err, "failed to patch subscription")
+	}
+
+	return toSubResponse(ctx, sub), nil
+}
+
+// applyPatch merges the request into sub and returns the fields that actually changed.
+func (p *patchSubUseCase) applyPatch(sub *entities.Subscription, req requests.SubPatchRequest) (entities.SubscriptionChanges, error) {
+	var changes entities.SubscriptionChanges
+
+	if req.ServiceName.Set {
+		if req.ServiceName.Null || req.ServiceName.Value == "" {
+			p.logger.Error().Msg("service_name can't be empty")
+			return changes, errors.Wrap(ErrInvalidField, "service_name can't be empty")
+		}
+		if utf8.RuneCountInString(req.ServiceName.Value) > maxServiceNameLength {
+			p.logger.Error().Msg("Service name is too long")
+			return changes, errors.Wrapf(ErrInvalidField, "service_name must be at most %d characters", maxServiceNameLength)
+		}
+		if req.ServiceName.Value != sub.ServiceName {
+			sub.ServiceName = req.ServiceName.Value
+			changes.ServiceName = &sub.ServiceName
+		}
+	}
+
+	if req.Price.Set {
+		if req.Price.Null || req.Price.Value <= 0 {
+			p.logger.Error().Msg("price must be positive")
+			return changes, errors.Wrap(ErrInvalidField, "price must be positive")
+		}
+		// the initial price stays, a new one goes to the price history
+		if req.Price.Value != currentPrice(*sub) {
+			p.logger.Error().Msg("Price changed in place")
+			return changes, errors.Wrap(ErrInvalidField, "price can't be changed in place, add it with POST /subscriptions/{sub_id}/prices")
+		}
+	}
+
+	if req.Currency.Set {
+		if req.Currency.Null || req.Currency.Value == "" {
+			p.logger.Error().Msg("currency can't be empty")
+			return changes, errors.Wrap(ErrInvalidField, "currency can't be empty")
+		}
+		currency, err := parseCurrency(req.Currency.Value)
+		if err != nil {
+			p.logger.Error().Err(err).Msg("Invalid currency")
+			return changes, errors.Wrap(err, "failed to parse currency")
+		}
+		if currency != sub.Currency {
+			sub.Currency = currency
+			changes.Currency = &sub.Currency
+		}
+	}
+
+	if req.BillingPeriod.Set {
+		if req.BillingPeriod.Null || req.BillingPeriod.Value == "" {
+			p.logger.Error().Msg("billing_period can't be empty")
+			return changes, errors.Wrap(ErrInvalidField, "billing_period can't be empty")
+		}
+		billingPeriod, err := parseBillingPeriod(req.BillingPeriod.Value)
+		if err != nil {
+			p.logger.Error().Err(err).Msg("Invalid billing period")
+			return changes, errors.Wrap(err, "failed to parse billing_period")
+		}
+		if billingPeriod != sub.BillingPeriod {
+			sub.BillingPeriod = billingPeriod
+			changes.BillingPeriod = &sub.BillingPeriod
+		}
+	}
+
+	if req.UserID.Set {
+		if req.UserID.Null {
+			p.logger.Error().Msg("user_id can't be null")
+			return changes, errors.Wrap(ErrInvalidField, "user_id can't be null")
+		}
+		userID, err := uuid.Parse(req.UserID.Value)
+		if err != nil {
+			p.logger.Error().Err(err).Msg("Invalid user_id format")
+			return changes, errors.Wrap(ErrInvalidUUID, "failed to parse user_id")
+		}
+		if userID != sub.UserID {
+			sub.UserID = userID
+			changes.UserID = &sub.UserID
+		}
+	}
+
+	if req.StartDate.Set {
+		if req.StartDate.Null {
+			p.logger.Error().Msg("start_date can't be null")
+			return changes, errors.Wrap(ErrInvalidField, "start_date can't be null")
+		}
+		startDate, err := parseStartDate(req.StartDate.Value)
+		if err != nil {
+			p.logger.Error().Err(err).Msg("Invalid start_date format")
+			return changes, errors.Wrap(err, "failed to parse start_date")
+		}
+		if !startDate.Equal(sub.StartDate) {
+			sub.StartDate = startDate
+			changes.StartDate = &sub.StartDate
+		}
+	}
+
+	if req.EndDate.Set {
+		if req.EndDate.Null {
+			if sub.EndDate != nil {
+				sub.EndDate = nil
+				changes.ClearEndDate = true
+			}
+		} else {
+			endDate, err := parseEndDate(req.EndDate.Value)
+			if err != nil {
+				p.logger.Error().Err(err).Msg("Invalid end_date format")
+				return changes, errors.Wrap(err, "failed to parse end_date")
+			}
+			if sub.EndDate == nil || !endDate.Equal(*sub.EndDate) {
+				sub.EndDate = &endDate
+				changes.EndDate = sub.EndDate
+			}
+		}
+	}
+
+	if req.TrialStart.Set || req.TrialEnd.Set {
+		if err := p.patchTrial(sub, req, &changes); err != nil {
+			return changes, err
+		}
+	}
+
+	return changes, nil
+}
+
+// patchTrial applies trial_start_date and trial_end_date. A null in either
+// removes the trial; a trial added without a start begins with the subscription.
+func (p *patchSubUseCase) patchTrial(sub *entities.Subscription, req requests.SubPatchRequest, changes *entities.SubscriptionChanges) error {
+	if req.TrialStart.Null || req.TrialEnd.Null {
+		if sub.TrialEndDate != nil {
+			sub.TrialStartDate, sub.TrialEndDate = nil, nil
+			changes.ClearTrial = true
+		}
+		return nil
+	}
+
+	trialStart, trialEnd := sub.TrialStartDate, sub.TrialEndDate
+	if req.TrialStart.Set {
+		start, err := parseStartDate(req.TrialStart.Value)
+		if err != nil {
+			p.logger.Error().Err(err).Msg("Invalid trial_start_date format")
+			return errors.Wrap(err, "failed to parse trial_start_date")
+		}
+		trialStart = &start
+	}
+	if req.TrialEnd.Set {
+		end, err := parseEndDate(req.TrialEnd.Value)
+		if err != nil {
+			p.logger.Error().Err(err).Msg("Invalid trial_end_date format")
+			return errors.Wrap(err, "failed to parse trial_end_date")
+		}
+		trialEnd = &end
+	}
+	if trialEnd == nil {
+		p.logger.Error().Msg("trial_end_date is required with trial_start_date")
+		return errors.Wrap(ErrInvalidPeriod, "trial_end_date is required with trial_start_date")
+	}
+	if trialStart == nil {
+		start := sub.StartDate
+		trialStart = &start
+	}
+
+	if sub.TrialStartDate == nil || !trialStart.Equal(*sub.TrialStartDate) {
+		changes.TrialStartDate = trialStart
+	}
+	if sub.TrialEndDate == nil || !trialEnd.Equal(*sub.TrialEndDate) {
+		changes.TrialEndDate = trialEnd
+	}
+	sub.TrialStartDate, sub.TrialEndDate = trialStart, trialEnd
+
+	return nil
+}
@@ -0,0 +1,293 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/entities"
+)
+
+var (
+	mockPatchSubRepo *MockPatchSubRepository
+)
+
+func initPatchSubTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockPatchSubRepo = NewMockPatchSubRepository(ctrl)
+}
+
+func patchRequest(t *testing.T, body string) requests.SubPatchRequest {
+	var req requests.SubPatchRequest
+	if err := json.Unmarshal([]byte(body), &req); err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+func patchTestSubscription(subID string) entities.Subscription {
+	startDate, _ := time.Parse("2006-01-02", "2025-07-01")
+	endDate, _ := time.Parse("2006-01-02", "2025-12-01")
+
+	return entities.Subscription{
+		ID:          uuid.MustParse(subID),
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		Currency:    entities.DefaultCurrency,
+		UserID:      uuid.New(),
+		StartDate:   startDate,
+		EndDate:     &endDate,
+		Version:     3,
+	}
+}
+
+func TestPatchSubscription_Success_ServiceNameOnly(t *testing.T) {
+	initPatchSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+	current := patchTestSubscription(subID)
+
+	name := "Kinopoisk"
+	mockPatchSubRepo.EXPECT().SelectByID(ctx, subID).Return(current, nil)
+	mockPatchSubRepo.EXPECT().Patch(ctx, subID, 3, entities.SubscriptionChanges{ServiceName: &name}).Return(4, nil)
+
+	useCase := NewPatchSubUseCase(mockPatchSubRepo, mockLogger)
+	response, err := useCase.PatchSubscription(ctx, subID, 3, patchRequest(t, `{"service_name": "Kinopoisk"}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Kinopoisk", response.ServiceName)
+	assert.Equal(t, current.Price, response.Price)
+	assert.Equal(t, current.UserID.String(), response.UserID)
+	assert.Equal(t, "12-2025", response.EndDate)
+	assert.Equal(t, 4, response.Version)
+}
+
+func TestPatchSubscription_Success_CurrentPriceKept(t *testing.T) {
+	initPatchSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+	current := patchTestSubscription(subID)
+	current.CurrentPrice = 500
+
+	mockPatchSubRepo.EXPECT().SelectByID(ctx, subID).Return(current, nil)
+
+	useCase := NewPatchSubUseCase(mockPatchSubRepo, mockLogger)
+	response, err := useCase.PatchSubscription(ctx, subID, 3, patchRequest(t, `{"price": 500}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 500, response.Price)
+	assert.Equal(t, 3, response.Version)
+}
+
+func TestPatchSubscription_Failure_PriceChangedInPlace(t *testing.T) {
+	initPatchSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+
+	mockPatchSubRepo.EXPECT().SelectByID(ctx, subID).Return(patchTestSubscription(subID), nil)
+
+	useCase := NewPatchSubUseCase(mockPatchSubRepo, mockLogger)
+	_, err := useCase.PatchSubscription(ctx, subID, 3, patchRequest(t, `{"price": 500}`))
+
+	assert.ErrorIs(t, err, ErrInvalidField)
+}
+
+func TestPatchSubscription_Success_Currency(t *testing.T) {
+	initPatchSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+	current := patchTestSubscription(subID)
+
+	currency := "USD"
+	mockPatchSubRepo.EXPECT().SelectByID(ctx, subID).Return(current, nil)
+	mockPatchSubRepo.EXPECT().Patch(ctx, subID, 3, entities.SubscriptionChanges{Currency: &currency}).Return(4, nil)
+
+	useCase := NewPatchSubUseCase(mockPatchSubRepo, mockLogger)
+	response, err := useCase.PatchSubscription(ctx, subID, 3, patchRequest(t, `{"currency": "USD"}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "USD", response.Currency)
+}
+
+func TestPatchSubscription_Failure_InvalidCurrency(t *testing.T) {
+	initPatchSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+
+	mockPatchSubRepo.EXPECT().SelectByID(ctx, subID).Return(patchTestSubscription(subID), nil)
+
+	useCase := NewPatchSubUseCase(mockPatchSubRepo, mockLogger)
+	_, err := useCase.PatchSubscription(ctx, subID, 3, patchRequest(t, `{"currency": "RUR"}`))
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidCurrency)
+}
+
+func TestPatchSubscription_Success_ClearEndDate(t *testing.T) {
+	initPatchSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+
+	mockPatchSubRepo.EXPECT().SelectByID(ctx, subID).Return(patchTestSubscription(subID), nil)
+	mockPatchSubRepo.EXPECT().Patch(ctx, subID, 3, entities.SubscriptionChanges{ClearEndDate: true}).Return(4, nil)
+
+	useCase := NewPatchSubUseCase(mockPatchSubRepo, mockLogger)
+	response, err := useCase.PatchSubscription(ctx, subID, 3, patchRequest(t, `{"end_date": null}`))
+
+	assert.NoError(t, err)
+	assert.Empty(t, response.EndDate)
+}
+
+func TestPatchSubscription_Success_AddTrial(t *testing.T) {
+	initPatchSubTestMocks(t)
+	ctx := WithDateFormat(context.Background(), DateFormatISO)
+	subID := uuid.New().String()
+	current := patchTestSubscription(subID)
+
+	trialEnd, _ := time.Parse("2006-01-02", "2025-07-14")
+	changes := entities.SubscriptionChanges{TrialStartDate: &current.StartDate, TrialEndDate: &trialEnd}
+	mockPatchSubRepo.EXPECT().SelectByID(ctx, subID).Return(current, nil)
+	mockPatchSubRepo.EXPECT().Patch(ctx, subID, 3, changes).Return(4, nil)
+
+	useCase := NewPatchSubUseCase(mockPatchSubRepo, mockLogger)
+	response, err := useCase.PatchSubscription(ctx, subID, 3, patchRequest(t, `{"trial_end_date": "2025-07-14"}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2025-07-01", response.TrialStart)
+	assert.Equal(t, "2025-07-14", response.TrialEnd)
+}
+
+func TestPatchSubscription_Success_ClearTrial(t *testing.T) {
+	initPatchSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+	current := patchTestSubscription(subID)
+	trialEnd, _ := time.Parse("2006-01-02", "2025-07-14")
+	current.TrialStartDate, current.TrialEndDate = &current.StartDate, &trialEnd
+
+	mockPatchSubRepo.EXPECT().SelectByID(ctx, subID).Return(current, nil)
+	mockPatchSubRepo.EXPECT().Patch(ctx, subID, 3, entities.SubscriptionChanges{ClearTrial: true}).Return(4, nil)
+
+	useCase := NewPatchSubUseCase(mockPatchSubRepo, mockLogger)
+	response, err := useCase.PatchSubscription(ctx, subID, 3, patchRequest(t, `{"trial_end_date": null}`))
+
+	assert.NoError(t, err)
+	assert.Empty(t, response.TrialEnd)
+}
+
+func TestPatchSubscription_Failure_TrialEndBeforeTrialStart(t *testing.T) {
+	initPatchSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+
+	mockPatchSubRepo.EXPECT().SelectByID(ctx, subID).Return(patchTestSubscription(subID), nil)
+
+	useCase := NewPatchSubUseCase(mockPatchSubRepo, mockLogger)
+	_, err := useCase.PatchSubscription(ctx, subID, 3, patchRequest(t, `{"trial_start_date": "2025-07-10", "trial_end_date": "2025-07-05"}`))
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
+}
+
+func TestPatchSubscription_Success_NothingChanged(t *testing.T) {
+	initPatchSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+
+	mockPatchSubRepo.EXPECT().SelectByID(ctx, subID).Return(patchTestSubscription(subID), nil)
+
+	useCase := NewPatchSubUseCase(mockPatchSubRepo, mockLogger)
+	response, err := useCase.PatchSubscription(ctx, subID, 3, patchRequest(t, `{"price": 400, "start_date": "07-2025"}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 400, response.Price)
+}
+
+func TestPatchSubscription_Failure_InvalidSubID(t *testing.T) {
+	initPatchSubTestMocks(t)
+	ctx := context.Background()
+
+	useCase := NewPatchSubUseCase(mockPatchSubRepo, mockLogger)
+	_, err := useCase.PatchSubscription(ctx, "invalid-uuid", 3, patchRequest(t, `{"price": 500}`))
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidUUID)
+}
+
+func TestPatchSubscription_Failure_VersionMismatch(t *testing.T) {
+	initPatchSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+
+	mockPatchSubRepo.EXPECT().SelectByID(ctx, subID).Return(patchTestSubscription(subID), nil)
+
+	useCase := NewPatchSubUseCase(mockPatchSubRepo, mockLogger)
+	_, err := useCase.PatchSubscription(ctx, subID, 2, patchRequest(t, `{"price": 500}`))
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrVersionMismatch)
+}
+
+func TestPatchSubscription_Failure_NullRequiredField(t *testing.T) {
+	initPatchSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+
+	mockPatchSubRepo.EXPECT().SelectByID(ctx, subID).Return(patchTestSubscription(subID), nil)
+
+	useCase := NewPatchSubUseCase(mockPatchSubRepo, mockLogger)
+	_, err := useCase.PatchSubscription(ctx, subID, 3, patchRequest(t, `{"service_name": null}`))
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidField)
+}
+
+func TestPatchSubscription_Failure_EndBeforeStart(t *testing.T) {
+	initPatchSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+
+	mockPatchSubRepo.EXPECT().SelectByID(ctx, subID).Return(patchTestSubscription(subID), nil)
+
+	useCase := NewPatchSubUseCase(mockPatchSubRepo, mockLogger)
+	_, err := useCase.PatchSubscription(ctx, subID, 3, patchRequest(t, `{"end_date": "01-2025"}`))
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
+}
+
+func TestPatchSubscription_Failure_NotFound(t *testing.T) {
+	initPatchSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+
+	mockPatchSubRepo.EXPECT().SelectByID(ctx, subID).Return(entities.Subscription{}, ErrEntityNotFound)
+
+	useCase := NewPatchSubUseCase(mockPatchSubRepo, mockLogger)
+	_, err := useCase.PatchSubscription(ctx, subID, 3, patchRequest(t, `{"price": 500}`))
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestPatchSubscription_Failure_DatabaseError(t *testing.T) {
+	initPatchSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+
+	expectedErr := errors.New("database error")
+	mockPatchSubRepo.EXPECT().SelectByID(ctx, subID).Return(patchTestSubscription(subID), nil)
+	mockPatchSubRepo.EXPECT().Patch(ctx, subID, 3, gomock.Any()).Return(0, expectedErr)
+
+	useCase := NewPatchSubUseCase(mockPatchSubRepo, mockLogger)
+	_, err := useCase.PatchSubscription(ctx, subID, 3, patchRequest(t, `{"service_name": "Spotify"}`))
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, expectedErr)
+}
@@ -0,0 +1,81 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+)
+
+type pauseSubUseCase struct {
+	subRepo PauseSubRepository
+	logger  logger.Logger
+}
+
+type PauseSubUseCase interface {
+	PauseSubscription(ctx context.Context, subID string, req requests.PauseSubscription) (responses.SubResponse, error)
+}
+
+func NewPauseSubUseCase(subRepo PauseSubRepository, logger logger.Logger) PauseSubUseCase {
+	return &pauseSubUseCase{
+		subRepo: subRepo,
+		logger:  logger,
+	}
+}
+
+// PauseSubscription stops billing the subscription from req.From, today by
+// default, until it is resumed. The subscription keeps its id and history.
+func (p *pauseSubUseCase) PauseSubscription(ctx context.Context, subID string, req requests.PauseSubscription) (responses.SubResponse, error) {
+	id, err := uuid.Parse(subID)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("Invalid sub_id format")
+		return responses.SubResponse{}, errors.Wrap(ErrInvalidUUID, "failed to parse sub_id")
+	}
+
+	from := today()
+	if req.From != "" {
+		from, err = parseStartDate(req.From)
+		if err != nil {
+			p.logger.Error().Err(err).Msg("Invalid from format")
+			return responses.SubResponse{}, errors.Wrap(err, "failed to parse from")
+		}
+	}
+
+	sub, err := p.subRepo.SelectByID(ctx, subID)
+	if err != nil {
+		p.logger.Error().Err(err).Msg("Failed to get subscription")
+		return responses.SubResponse{}, errors.Wrap(err, "failed to get subscription")
+	}
+
+	if sub.LastPause != nil && sub.LastPause.ResumedAt == nil {
+		p.logger.Error().Msg("Subscription is already paused")
+		return responses.SubResponse{}, errors.Wrap(ErrInvalidStatus, "subscription is already paused")
+	}
+	if from.Before(sub.StartDate) {
+		p.logger.Error().Msg("Pause starts before start_date")
+		return responses.SubResponse{}, errors.Wrap(ErrInvalidPeriod, "pause can't start before start_date")
+	}
+	if sub.EndDate != nil && from.After(*sub.EndDate) {
+		p.logger.Error().Msg("Pause starts after end_date")
+		return responses.SubResponse{}, errors.Wrap(ErrInvalidPeriod, "pause can't start after end_date")
+	}
+	if sub.LastPause != nil && from.Before(*sub.LastPause.ResumedAt) {
+		p.logger.Error().Msg("Pause overlaps the previous one")
+		return responses.SubResponse{}, errors.Wrap(ErrInvalidPeriod, "pause can't start before the previous one ended")
+	}
+
+	pause := entities.SubscriptionPause{
+		SubscriptionID: id,
+		PausedFrom:     from,
+	}
+	if err := p.subRepo.Pause(ctx, pause); err != nil {
+		p.logger.Error().Err(err).Msg("Failed to insert subscription pause")
+		return responses.SubResponse{}, errors.Wrap(err, "failed to pause subscription")
+	}
+
+	sub.LastPause = &pause
+	return toSubResponse(ctx, sub), nil
+}
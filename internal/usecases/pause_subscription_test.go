@@ -0,0 +1,130 @@
+package usecases
+
+import (
+	"context"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var mockPauseSubRepo *MockPauseSubRepository
+
+func initPauseSubTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockPauseSubRepo = NewMockPauseSubRepository(ctrl)
+	mockLogger = logger.NewMockLogger(t)
+}
+
+func TestPauseSubscription_Success(t *testing.T) {
+	initPauseSubTestMocks(t)
+	ctx := context.Background()
+	startDate, _ := time.Parse("2006-01-02", "2025-01-01")
+	from, _ := time.Parse("2006-01-02", "2025-06-01")
+	sub := entities.Subscription{ID: uuid.New(), Price: 400, UserID: uuid.New(), StartDate: startDate}
+
+	mockPauseSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+	mockPauseSubRepo.EXPECT().Pause(ctx, entities.SubscriptionPause{SubscriptionID: sub.ID, PausedFrom: from}).Return(nil)
+
+	useCase := NewPauseSubUseCase(mockPauseSubRepo, mockLogger)
+	response, err := useCase.PauseSubscription(ctx, sub.ID.String(), requests.PauseSubscription{From: "2025-06-01"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, entities.StatusPaused, response.Status)
+}
+
+func TestPauseSubscription_Success_DefaultsToToday(t *testing.T) {
+	initPauseSubTestMocks(t)
+	ctx := context.Background()
+	startDate, _ := time.Parse("2006-01-02", "2025-01-01")
+	sub := entities.Subscription{ID: uuid.New(), Price: 400, UserID: uuid.New(), StartDate: startDate}
+
+	mockPauseSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+	mockPauseSubRepo.EXPECT().Pause(ctx, entities.SubscriptionPause{SubscriptionID: sub.ID, PausedFrom: today()}).Return(nil)
+
+	useCase := NewPauseSubUseCase(mockPauseSubRepo, mockLogger)
+	response, err := useCase.PauseSubscription(ctx, sub.ID.String(), requests.PauseSubscription{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, entities.StatusPaused, response.Status)
+}
+
+func TestPauseSubscription_Failure_AlreadyPaused(t *testing.T) {
+	initPauseSubTestMocks(t)
+	ctx := context.Background()
+	startDate, _ := time.Parse("2006-01-02", "2025-01-01")
+	pausedFrom, _ := time.Parse("2006-01-02", "2025-06-01")
+	sub := entities.Subscription{ID: uuid.New(), Price: 400, UserID: uuid.New(), StartDate: startDate}
+	sub.LastPause = &entities.SubscriptionPause{SubscriptionID: sub.ID, PausedFrom: pausedFrom}
+
+	mockPauseSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+
+	useCase := NewPauseSubUseCase(mockPauseSubRepo, mockLogger)
+	_, err := useCase.PauseSubscription(ctx, sub.ID.String(), requests.PauseSubscription{From: "2025-07-01"})
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidStatus)
+}
+
+func TestPauseSubscription_Failure_OverlapsPreviousPause(t *testing.T) {
+	initPauseSubTestMocks(t)
+	ctx := context.Background()
+	startDate, _ := time.Parse("2006-01-02", "2025-01-01")
+	pausedFrom, _ := time.Parse("2006-01-02", "2025-06-01")
+	resumedAt, _ := time.Parse("2006-01-02", "2025-09-01")
+	sub := entities.Subscription{ID: uuid.New(), Price: 400, UserID: uuid.New(), StartDate: startDate}
+	sub.LastPause = &entities.SubscriptionPause{SubscriptionID: sub.ID, PausedFrom: pausedFrom, ResumedAt: &resumedAt}
+
+	mockPauseSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+
+	useCase := NewPauseSubUseCase(mockPauseSubRepo, mockLogger)
+	_, err := useCase.PauseSubscription(ctx, sub.ID.String(), requests.PauseSubscription{From: "2025-08-01"})
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
+}
+
+func TestPauseSubscription_Failure_BeforeStart(t *testing.T) {
+	initPauseSubTestMocks(t)
+	ctx := context.Background()
+	startDate, _ := time.Parse("2006-01-02", "2025-01-01")
+	sub := entities.Subscription{ID: uuid.New(), Price: 400, UserID: uuid.New(), StartDate: startDate}
+
+	mockPauseSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+
+	useCase := NewPauseSubUseCase(mockPauseSubRepo, mockLogger)
+	_, err := useCase.PauseSubscription(ctx, sub.ID.String(), requests.PauseSubscription{From: "12-2024"})
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
+}
+
+func TestPauseSubscription_Failure_InvalidSubID(t *testing.T) {
+	initPauseSubTestMocks(t)
+	ctx := context.Background()
+
+	useCase := NewPauseSubUseCase(mockPauseSubRepo, mockLogger)
+	_, err := useCase.PauseSubscription(ctx, "invalid-uuid", requests.PauseSubscription{})
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidUUID)
+}
+
+func TestPauseSubscription_Failure_NotFound(t *testing.T) {
+	initPauseSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+
+	mockPauseSubRepo.EXPECT().SelectByID(ctx, subID).Return(entities.Subscription{}, ErrEntityNotFound)
+
+	useCase := NewPauseSubUseCase(mockPauseSubRepo, mockLogger)
+	_, err := useCase.PauseSubscription(ctx, subID, requests.PauseSubscription{})
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
@@ -0,0 +1,65 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"sort"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
+)
+
+// priceSchedule holds the price changes of each subscription ordered by effective month.
+type priceSchedule map[uuid.UUID][]entities.SubscriptionPrice
+
+func newPriceSchedule(prices []entities.SubscriptionPrice) priceSchedule {
+	schedule := make(priceSchedule)
+	for _, price := range prices {
+		schedule[price.SubscriptionID] = append(schedule[price.SubscriptionID], price)
+	}
+	for _, changes := range schedule {
+		sort.Slice(changes, func(i, j int) bool {
+			return changes[i].EffectiveFrom.Before(changes[j].EffectiveFrom)
+		})
+	}
+
+	return schedule
+}
+
+// priceAt returns the price of the subscription effective in the given month index.
+func (s priceSchedule) priceAt(sub entities.Subscription, month int) int {
+	price := sub.Price
+	for _, change := range s[sub.ID] {
+		if monthIndex(change.EffectiveFrom) > month {
+			break
+		}
+		price = change.Price
+	}
+
+	return price
+}
+
+// currentPrice returns the price effective today. A subscription that was
+// just built rather than read has no price changes yet.
+func currentPrice(sub entities.Subscription) int {
+	if sub.CurrentPrice > 0 {
+		return sub.CurrentPrice
+	}
+
+	return sub.Price
+}
+
+// history lists the initial price of the subscription followed by its changes.
+func (s priceSchedule) history(ctx context.Context, sub entities.Subscription) []responses.SubPrice {
+	history := []responses.SubPrice{{
+		Price:         sub.Price,
+		EffectiveFrom: formatDate(ctx, sub.StartDate),
+	}}
+	for _, change := range s[sub.ID] {
+		history = append(history, responses.SubPrice{
+			Price:         change.Price,
+			EffectiveFrom: formatDate(ctx, change.EffectiveFrom),
+		})
+	}
+
+	return history
+}
@@ -0,0 +1,95 @@
+package usecases
+
+import (
+	"math"
+	"subscription_service/internal/entities"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ProrationNone bills partial months in full.
+	ProrationNone = "none"
+	// ProrationDaily bills partial months by the daily rate times active days.
+	ProrationDaily = "daily"
+	// ProrationHalfMonth bills every partial month as half a month.
+	ProrationHalfMonth = "half_month"
+)
+
+func parseProration(proration string) (string, error) {
+	switch proration {
+	case "":
+		return ProrationNone, nil
+	case ProrationNone, ProrationDaily, ProrationHalfMonth:
+		return proration, nil
+	default:
+		return "", errors.Wrapf(ErrInvalidProration, "unknown proration %q", proration)
+	}
+}
+
+// prorate scales the cost of a month by the part of it the subscription is
+// active in within [startPeriod, endPeriod]. Discrete charges of weekly,
+// quarterly and yearly plans are never prorated.
+func prorate(cost int, sub entities.Subscription, month int, startPeriod, endPeriod time.Time, mode, proration string) int {
+	if proration == ProrationNone {
+		return cost
+	}
+	if sub.BillingPeriod != entities.BillingMonthly && mode != CostModeAmortized {
+		return cost
+	}
+
+	active, days := activeDays(sub, month, startPeriod, endPeriod)
+	if active == days {
+		return cost
+	}
+
+	if proration == ProrationHalfMonth {
+		return int(math.Round(float64(cost) / 2))
+	}
+
+	return int(math.Round(float64(cost) * float64(active) / float64(days)))
+}
+
+// activeDays returns how many days of the month the subscription is active in
+// and neither on trial nor paused within the period, along with the number of days in the month.
+func activeDays(sub entities.Subscription, month int, startPeriod, endPeriod time.Time) (int, int) {
+	first := monthStart(month)
+	last := monthStart(month+1).AddDate(0, 0, -1)
+	days := daysBetween(first, last) + 1
+
+	from := latest(first, sub.StartDate, startPeriod)
+	to := earliest(last, endPeriod)
+	if sub.EndDate != nil {
+		to = earliest(to, *sub.EndDate)
+	}
+
+	active := 0
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		if !freeDay(sub, day) {
+			active++
+		}
+	}
+
+	return active, days
+}
+
+func latest(first time.Time, rest ...time.Time) time.Time {
+	for _, t := range rest {
+		if t.After(first) {
+			first = t
+		}
+	}
+
+	return first
+}
+
+func earliest(first time.Time, rest ...time.Time) time.Time {
+	for _, t := range rest {
+		if t.Before(first) {
+			first = t
+		}
+	}
+
+	return first
+}
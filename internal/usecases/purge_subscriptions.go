@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/pkg/logger"
+	"time"
+)
+
+type purgeSubsUseCase struct {
+	subRepo   PurgeSubsRepository
+	retention time.Duration
+	logger    logger.Logger
+}
+
+type PurgeSubsUseCase interface {
+	PurgeSubscriptions(ctx context.Context) (responses.PurgeSubscriptions, error)
+}
+
+func NewPurgeSubsUseCase(subRepo PurgeSubsRepository, retention time.Duration, logger logger.Logger) PurgeSubsUseCase {
+	return &purgeSubsUseCase{
+		subRepo:   subRepo,
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+// PurgeSubscriptions permanently removes subscriptions deleted longer than
+// the retention ago. They can't be restored afterwards.
+func (p *purgeSubsUseCase) PurgeSubscriptions(ctx context.Context) (responses.PurgeSubscriptions, error) {
+	purged, err := p.subRepo.Purge(ctx, time.Now().Add(-p.retention))
+	if err != nil {
+		p.logger.Error().Err(err).Msg("Failed to purge subscriptions")
+		return responses.PurgeSubscriptions{}, errors.Wrap(err, "failed to purge subscriptions")
+	}
+
+	p.logger.Info().Msgf("Purged %d deleted subscriptions", purged)
+	return responses.PurgeSubscriptions{Purged: purged}, nil
+}
@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+var mockPurgeSubsRepo *MockPurgeSubsRepository
+
+func initPurgeSubsTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockPurgeSubsRepo = NewMockPurgeSubsRepository(ctrl)
+	mockLogger = logger.NewMockLogger(t)
+}
+
+func TestPurgeSubscriptions_Success(t *testing.T) {
+	initPurgeSubsTestMocks(t)
+	ctx := context.Background()
+	retention := 30 * 24 * time.Hour
+
+	mockPurgeSubsRepo.EXPECT().Purge(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, deletedBefore time.Time) (int, error) {
+			// Only rows deleted before the retention window are purged.
+			assert.WithinDuration(t, time.Now().Add(-retention), deletedBefore, time.Minute)
+			return 2, nil
+		})
+
+	useCase := NewPurgeSubsUseCase(mockPurgeSubsRepo, retention, mockLogger)
+	response, err := useCase.PurgeSubscriptions(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, response.Purged)
+}
+
+func TestPurgeSubscriptions_Failure_RepositoryError(t *testing.T) {
+	initPurgeSubsTestMocks(t)
+	ctx := context.Background()
+
+	expectedErr := errors.New("database error")
+	mockPurgeSubsRepo.EXPECT().Purge(ctx, gomock.Any()).Return(0, expectedErr)
+
+	useCase := NewPurgeSubsUseCase(mockPurgeSubsRepo, time.Hour, mockLogger)
+	_, err := useCase.PurgeSubscriptions(ctx)
+
+	assert.ErrorIs(t, err, expectedErr)
+}
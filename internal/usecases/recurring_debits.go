@@ -0,0 +1,177 @@
+package usecases
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strings"
+	"subscription_service/internal/entities"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MinRecurringCharges is how many monthly debits a merchant needs before
+	// it is suggested as a subscription.
+	MinRecurringCharges = 3
+	// Consecutive monthly charges are this many days apart, allowing for
+	// weekends, bank holidays and months of different length.
+	minChargeIntervalDays = 25
+	maxChargeIntervalDays = 35
+	// chargeAmountTolerance is how much a charge may differ from the previous
+	// one, so that a small price change doesn't break the series.
+	chargeAmountTolerance = 0.1
+	// lapsedChargeDays is how long before the end of the statement the last
+	// charge may be for the subscription to still count as running.
+	lapsedChargeDays = 45
+	// maxServiceNameLength matches the service_name column.
+	maxServiceNameLength = 255
+)
+
+// detectRecurringDebits finds merchants the statement shows monthly debits
+// of a similar amount to and proposes each as a monthly subscription priced
+// at the latest charge.
+func detectRecurringDebits(userID uuid.UUID, transactions []entities.BankTransaction) []entities.SubscriptionSuggestion {
+	type seriesKey struct {
+		merchant string
+		currency string
+	}
+
+	var statementEnd time.Time
+	seen := make(map[string]bool)
+	debits := make(map[seriesKey][]entities.BankTransaction)
+	for _, transaction := range transactions {
+		if transaction.BookedAt.After(statementEnd) {
+			statementEnd = transaction.BookedAt
+		}
+		if !transaction.IsDebit() {
+			continue
+		}
+		if transaction.ID != "" {
+			if seen[transaction.ID] {
+				continue
+			}
+			seen[transaction.ID] = true
+		}
+
+		merchant := merchantKey(transaction.Counterparty)
+		if merchant == "" {
+			continue
+		}
+		key := seriesKey{merchant: merchant, currency: transaction.Currency}
+		debits[key] = append(debits[key], transaction)
+	}
+
+	var suggestions []entities.SubscriptionSuggestion
+	for key, charges := range debits {
+		series := monthlySeries(charges)
+		if len(series) < MinRecurringCharges {
+			continue
+		}
+
+		first, last := series[0], series[len(series)-1]
+		if daysBetween(last.BookedAt, statementEnd) > lapsedChargeDays {
+			continue
+		}
+
+		name := merchantName(last.Counterparty)
+		if name == "" {
+			name = key.merchant
+		}
+
+		suggestions = append(suggestions, entities.SubscriptionSuggestion{
+			UserID:          userID,
+			Merchant:        key.merchant,
+			ServiceName:     name,
+			Price:           max(1, int(math.Round(float64(-last.Amount)/100))),
+			Currency:        key.currency,
+			BillingPeriod:   entities.BillingMonthly,
+			FirstChargeDate: first.BookedAt,
+			LastChargeDate:  last.BookedAt,
+			Charges:         len(series),
+			Status:          entities.SuggestionPending,
+		})
+	}
+
+	slices.SortFunc(suggestions, func(a, b entities.SubscriptionSuggestion) int {
+		return cmp.Or(cmp.Compare(a.Merchant, b.Merchant), cmp.Compare(a.Currency, b.Currency))
+	})
+
+	return suggestions
+}
+
+// monthlySeries returns the longest chain of charges that follow each other
+// at monthly intervals with a similar amount. Other debits to the same
+// merchant, such as one-off purchases, are skipped.
+func monthlySeries(charges []entities.BankTransaction) []entities.BankTransaction {
+	slices.SortStableFunc(charges, func(a, b entities.BankTransaction) int {
+		return a.BookedAt.Compare(b.BookedAt)
+	})
+
+	length := make([]int, len(charges))
+	previous := make([]int, len(charges))
+	best := -1
+	for i := range charges {
+		length[i], previous[i] = 1, -1
+		for j := i - 1; j >= 0; j-- {
+			if !followsMonthly(charges[j], charges[i]) || length[j]+1 <= length[i] {
+				continue
+			}
+			length[i], previous[i] = length[j]+1, j
+		}
+		// ties go to the later chain, which reflects the current price
+		if best < 0 || length[i] >= length[best] {
+			best = i
+		}
+	}
+
+	var series []entities.BankTransaction
+	for i := best; i >= 0; i = previous[i] {
+		series = append(series, charges[i])
+	}
+	slices.Reverse(series)
+
+	return series
+}
+
+func followsMonthly(prev, next entities.BankTransaction) bool {
+	days := daysBetween(prev.BookedAt, next.BookedAt)
+	if days < minChargeIntervalDays || days > maxChargeIntervalDays {
+		return false
+	}
+
+	return math.Abs(float64(next.Amount-prev.Amount)) <= math.Abs(float64(prev.Amount))*chargeAmountTolerance
+}
+
+// merchantKey normalizes a counterparty name so that debits to the same
+// merchant match even when banks append card numbers, dates or references:
+// "NETFLIX.COM 866-579-7172" and "Netflix.com" give the same key.
+func merchantKey(counterparty string) string {
+	words := strings.FieldsFunc(strings.ToUpper(counterparty), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	return truncateRunes(strings.Join(slices.DeleteFunc(words, hasDigit), " "), maxServiceNameLength)
+}
+
+// merchantName is the counterparty as the bank shows it, without the words
+// that carry numbers.
+func merchantName(counterparty string) string {
+	words := strings.Fields(counterparty)
+
+	return truncateRunes(strings.Join(slices.DeleteFunc(words, hasDigit), " "), maxServiceNameLength)
+}
+
+func hasDigit(word string) bool {
+	return strings.ContainsFunc(word, unicode.IsDigit)
+}
+
+func truncateRunes(value string, limit int) string {
+	if runes := []rune(value); len(runes) > limit {
+		return string(runes[:limit])
+	}
+
+	return value
+}
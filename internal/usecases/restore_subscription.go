@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/pkg/logger"
+)
+
+type restoreSubUseCase struct {
+	subRepo RestoreSubRepository
+	logger  logger.Logger
+}
+
+type RestoreSubUseCase interface {
+	RestoreSubscription(ctx context.Context, subID string) (responses.SubResponse, error)
+}
+
+func NewRestoreSubUseCase(subRepo RestoreSubRepository, logger logger.Logger) RestoreSubUseCase {
+	return &restoreSubUseCase{
+		subRepo: subRepo,
+		logger:  logger,
+	}
+}
+
+// RestoreSubscription undoes a deletion that hasn't been purged yet.
+func (r *restoreSubUseCase) RestoreSubscription(ctx context.Context, subID string) (responses.SubResponse, error) {
+	if _, err := uuid.Parse(subID); err != nil {
+		r.logger.Error().Err(err).Msg("Invalid sub_id format")
+		return responses.SubResponse{}, errors.Wrap(ErrInvalidUUID, "failed to parse sub_id")
+	}
+
+	if err := r.subRepo.Restore(ctx, subID); err != nil {
+		r.logger.Error().Err(err).Msg("Failed to restore subscription")
+		return responses.SubResponse{}, errors.Wrap(err, "failed to restore subscription")
+	}
+
+	sub, err := r.subRepo.SelectByID(ctx, subID)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to get subscription")
+		return responses.SubResponse{}, errors.Wrap(err, "failed to get subscription")
+	}
+
+	return toSubResponse(ctx, sub), nil
+}
@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var mockRestoreSubRepo *MockRestoreSubRepository
+
+func initRestoreSubTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRestoreSubRepo = NewMockRestoreSubRepository(ctrl)
+	mockLogger = logger.NewMockLogger(t)
+}
+
+func TestRestoreSubscription_Success(t *testing.T) {
+	initRestoreSubTestMocks(t)
+	ctx := context.Background()
+	startDate, _ := time.Parse("2006-01-02", "2025-01-01")
+	sub := entities.Subscription{ID: uuid.New(), ServiceName: "Yandex Plus", Price: 400, UserID: uuid.New(), StartDate: startDate, Version: 3}
+
+	mockRestoreSubRepo.EXPECT().Restore(ctx, sub.ID.String()).Return(nil)
+	mockRestoreSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+
+	useCase := NewRestoreSubUseCase(mockRestoreSubRepo, mockLogger)
+	response, err := useCase.RestoreSubscription(ctx, sub.ID.String())
+
+	assert.NoError(t, err)
+	assert.Equal(t, sub.ID.String(), response.ID)
+	assert.Equal(t, 3, response.Version)
+}
+
+func TestRestoreSubscription_Failure_InvalidID(t *testing.T) {
+	initRestoreSubTestMocks(t)
+
+	useCase := NewRestoreSubUseCase(mockRestoreSubRepo, mockLogger)
+	_, err := useCase.RestoreSubscription(context.Background(), "invalid-uuid")
+
+	assert.ErrorIs(t, err, ErrInvalidUUID)
+}
+
+func TestRestoreSubscription_Failure_NotDeleted(t *testing.T) {
+	initRestoreSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+
+	mockRestoreSubRepo.EXPECT().Restore(ctx, subID).Return(errors.Wrap(ErrEntityNotFound, "no deleted subscription"))
+
+	useCase := NewRestoreSubUseCase(mockRestoreSubRepo, mockLogger)
+	_, err := useCase.RestoreSubscription(ctx, subID)
+
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
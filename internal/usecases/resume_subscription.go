@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/pkg/logger"
+)
+
+type resumeSubUseCase struct {
+	subRepo ResumeSubRepository
+	logger  logger.Logger
+}
+
+type ResumeSubUseCase interface {
+	ResumeSubscription(ctx context.Context, subID string, req requests.ResumeSubscription) (responses.SubResponse, error)
+}
+
+func NewResumeSubUseCase(subRepo ResumeSubRepository, logger logger.Logger) ResumeSubUseCase {
+	return &resumeSubUseCase{
+		subRepo: subRepo,
+		logger:  logger,
+	}
+}
+
+// ResumeSubscription ends the current pause: the subscription is billed again
+// from req.On, today by default.
+func (r *resumeSubUseCase) ResumeSubscription(ctx context.Context, subID string, req requests.ResumeSubscription) (responses.SubResponse, error) {
+	if _, err := uuid.Parse(subID); err != nil {
+		r.logger.Error().Err(err).Msg("Invalid sub_id format")
+		return responses.SubResponse{}, errors.Wrap(ErrInvalidUUID, "failed to parse sub_id")
+	}
+
+	on := today()
+	if req.On != "" {
+		var err error
+		on, err = parseStartDate(req.On)
+		if err != nil {
+			r.logger.Error().Err(err).Msg("Invalid on format")
+			return responses.SubResponse{}, errors.Wrap(err, "failed to parse on")
+		}
+	}
+
+	sub, err := r.subRepo.SelectByID(ctx, subID)
+	if err != nil {
+		r.logger.Error().Err(err).Msg("Failed to get subscription")
+		return responses.SubResponse{}, errors.Wrap(err, "failed to get subscription")
+	}
+
+	if sub.LastPause == nil || sub.LastPause.ResumedAt != nil {
+		r.logger.Error().Msg("Subscription is not paused")
+		return responses.SubResponse{}, errors.Wrap(ErrInvalidStatus, "subscription is not paused")
+	}
+	if on.Before(sub.LastPause.PausedFrom) {
+		r.logger.Error().Msg("Resume date is before the pause start")
+		return responses.SubResponse{}, errors.Wrap(ErrInvalidPeriod, "subscription can't be resumed before the pause started")
+	}
+
+	if err := r.subRepo.Resume(ctx, sub.ID, on); err != nil {
+		r.logger.Error().Err(err).Msg("Failed to resume subscription")
+		return responses.SubResponse{}, errors.Wrap(err, "failed to resume subscription")
+	}
+
+	sub.LastPause.ResumedAt = &on
+	return toSubResponse(ctx, sub), nil
+}
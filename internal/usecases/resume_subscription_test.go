@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"context"
+	"subscription_service/internal/controllers/requests"
+	"subscription_service/internal/entities"
+	"subscription_service/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var mockResumeSubRepo *MockResumeSubRepository
+
+func initResumeSubTestMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockResumeSubRepo = NewMockResumeSubRepository(ctrl)
+	mockLogger = logger.NewMockLogger(t)
+}
+
+func pausedTestSubscription() entities.Subscription {
+	startDate, _ := time.Parse("2006-01-02", "2025-01-01")
+	pausedFrom, _ := time.Parse("2006-01-02", "2025-06-01")
+	sub := entities.Subscription{ID: uuid.New(), Price: 400, UserID: uuid.New(), StartDate: startDate}
+	sub.LastPause = &entities.SubscriptionPause{SubscriptionID: sub.ID, PausedFrom: pausedFrom}
+	return sub
+}
+
+func TestResumeSubscription_Success(t *testing.T) {
+	initResumeSubTestMocks(t)
+	ctx := context.Background()
+	sub := pausedTestSubscription()
+	on, _ := time.Parse("2006-01-02", "2025-09-01")
+
+	mockResumeSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+	mockResumeSubRepo.EXPECT().Resume(ctx, sub.ID, on).Return(nil)
+
+	useCase := NewResumeSubUseCase(mockResumeSubRepo, mockLogger)
+	response, err := useCase.ResumeSubscription(ctx, sub.ID.String(), requests.ResumeSubscription{On: "2025-09-01"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, entities.StatusActive, response.Status)
+}
+
+func TestResumeSubscription_Failure_NotPaused(t *testing.T) {
+	initResumeSubTestMocks(t)
+	ctx := context.Background()
+	sub := pausedTestSubscription()
+	sub.LastPause = nil
+
+	mockResumeSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+
+	useCase := NewResumeSubUseCase(mockResumeSubRepo, mockLogger)
+	_, err := useCase.ResumeSubscription(ctx, sub.ID.String(), requests.ResumeSubscription{})
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidStatus)
+}
+
+func TestResumeSubscription_Failure_BeforePauseStart(t *testing.T) {
+	initResumeSubTestMocks(t)
+	ctx := context.Background()
+	sub := pausedTestSubscription()
+
+	mockResumeSubRepo.EXPECT().SelectByID(ctx, sub.ID.String()).Return(sub, nil)
+
+	useCase := NewResumeSubUseCase(mockResumeSubRepo, mockLogger)
+	_, err := useCase.ResumeSubscription(ctx, sub.ID.String(), requests.ResumeSubscription{On: "2025-05-15"})
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
+}
+
+func TestResumeSubscription_Failure_InvalidDate(t *testing.T) {
+	initResumeSubTestMocks(t)
+	ctx := context.Background()
+
+	useCase := NewResumeSubUseCase(mockResumeSubRepo, mockLogger)
+	_, err := useCase.ResumeSubscription(ctx, uuid.New().String(), requests.ResumeSubscription{On: "2025/09/01"})
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidDateFormat)
+}
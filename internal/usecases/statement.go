@@ -0,0 +1,325 @@
+package usecases
+
+import (
+	"bytes"
+	"encoding/xml"
+	"html"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"subscription_service/internal/entities"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	StatementFormatOFX     = "ofx"
+	StatementFormatCAMT053 = "camt053"
+)
+
+// MaxStatementBytes limits the size of an uploaded bank statement.
+const MaxStatementBytes = 10 << 20
+
+const ofxDateLayout = "20060102"
+
+// parseStatement reads the transactions of an OFX or CAMT.053 statement.
+// An empty format is detected from the content.
+func parseStatement(body io.Reader, format string) (string, []entities.BankTransaction, error) {
+	data, err := io.ReadAll(io.LimitReader(body, MaxStatementBytes+1))
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to read statement")
+	}
+	if len(data) > MaxStatementBytes {
+		return "", nil, errors.Wrapf(ErrInvalidField, "statement is larger than %d bytes", MaxStatementBytes)
+	}
+
+	if format == "" {
+		format = detectStatementFormat(data)
+	}
+
+	var transactions []entities.BankTransaction
+	switch format {
+	case StatementFormatOFX:
+		transactions, err = parseOFX(data)
+	case StatementFormatCAMT053:
+		transactions, err = parseCAMT053(data)
+	default:
+		return "", nil, errors.Wrap(ErrInvalidField, "unknown statement format, expected OFX or CAMT.053")
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	return format, transactions, nil
+}
+
+func detectStatementFormat(data []byte) string {
+	switch {
+	case bytes.Contains(data, []byte("<OFX>")):
+		return StatementFormatOFX
+	case bytes.Contains(data, []byte("BkToCstmrStmt")):
+		return StatementFormatCAMT053
+	default:
+		return ""
+	}
+}
+
+// parseOFX reads the STMTTRN records of an OFX statement. Both OFX 1.x, an
+// SGML dialect whose leaf elements have no closing tags, and XML based OFX 2.x
+// are read by the same tag scanner.
+func parseOFX(data []byte) ([]entities.BankTransaction, error) {
+	start := bytes.Index(data, []byte("<OFX>"))
+	if start < 0 {
+		return nil, errors.Wrap(ErrInvalidField, "OFX element not found")
+	}
+
+	var (
+		transactions []entities.BankTransaction
+		current      *ofxTransaction
+		currency     = entities.DefaultCurrency
+	)
+	finish := func() error {
+		if current == nil {
+			return nil
+		}
+		transaction, err := current.build(currency, len(transactions)+1)
+		if err != nil {
+			return err
+		}
+		transactions = append(transactions, transaction)
+		current = nil
+		return nil
+	}
+
+	rest := string(data[start:])
+	for {
+		open := strings.IndexByte(rest, '<')
+		if open < 0 {
+			break
+		}
+		end := strings.IndexByte(rest[open:], '>')
+		if end < 0 {
+			break
+		}
+		tag := strings.ToUpper(strings.TrimSpace(rest[open+1 : open+end]))
+		rest = rest[open+end+1:]
+
+		value := rest
+		if next := strings.IndexByte(rest, '<'); next >= 0 {
+			value = rest[:next]
+		}
+		value = strings.TrimSpace(html.UnescapeString(value))
+
+		switch tag {
+		case "STMTTRN":
+			if err := finish(); err != nil {
+				return nil, err
+			}
+			current = &ofxTransaction{}
+		case "/STMTTRN", "/BANKTRANLIST":
+			if err := finish(); err != nil {
+				return nil, err
+			}
+		case "CURDEF":
+			if value != "" {
+				currency = strings.ToUpper(value)
+			}
+		default:
+			if current != nil && value != "" {
+				current.set(tag, value)
+			}
+		}
+	}
+	if err := finish(); err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+type ofxTransaction struct {
+	id     string
+	posted string
+	amount string
+	name   string
+	memo   string
+}
+
+func (t *ofxTransaction) set(tag, value string) {
+	switch tag {
+	case "FITID":
+		t.id = value
+	case "DTPOSTED":
+		t.posted = value
+	case "TRNAMT":
+		t.amount = value
+	case "NAME":
+		t.name = value
+	case "MEMO":
+		t.memo = value
+	}
+}
+
+func (t *ofxTransaction) build(currency string, number int) (entities.BankTransaction, error) {
+	// dates look like 20250115120000.000[-5:EST], only the day matters
+	if len(t.posted) < len(ofxDateLayout) {
+		return entities.BankTransaction{}, errors.Wrapf(ErrInvalidField, "transaction %d: invalid DTPOSTED %q", number, t.posted)
+	}
+	bookedAt, err := time.Parse(ofxDateLayout, t.posted[:len(ofxDateLayout)])
+	if err != nil {
+		return entities.BankTransaction{}, errors.Wrapf(ErrInvalidField, "transaction %d: invalid DTPOSTED %q", number, t.posted)
+	}
+
+	amount, err := parseMinorUnits(t.amount)
+	if err != nil {
+		return entities.BankTransaction{}, errors.Wrapf(ErrInvalidField, "transaction %d: invalid TRNAMT %q", number, t.amount)
+	}
+
+	counterparty := t.name
+	if counterparty == "" {
+		counterparty = t.memo
+	}
+
+	return entities.BankTransaction{
+		ID:           t.id,
+		BookedAt:     bookedAt,
+		Amount:       amount,
+		Currency:     currency,
+		Counterparty: counterparty,
+	}, nil
+}
+
+type camtDocument struct {
+	Statements []struct {
+		Entries []camtEntry `xml:"Ntry"`
+	} `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtEntry struct {
+	Reference         string `xml:"NtryRef"`
+	ServicerReference string `xml:"AcctSvcrRef"`
+	Amount            struct {
+		Value    string `xml:",chardata"`
+		Currency string `xml:"Ccy,attr"`
+	} `xml:"Amt"`
+	Indicator string `xml:"CdtDbtInd"`
+	// Status is a plain code up to camt.053.001.07 and wrapped in Cd since.
+	Status struct {
+		Value string `xml:",chardata"`
+		Code  string `xml:"Cd"`
+	} `xml:"Sts"`
+	BookingDate camtDate `xml:"BookgDt"`
+	ValueDate   camtDate `xml:"ValDt"`
+	Details     []struct {
+		Creditor      string   `xml:"RltdPties>Cdtr>Nm"`
+		CreditorParty string   `xml:"RltdPties>Cdtr>Pty>Nm"`
+		Remittance    []string `xml:"RmtInf>Ustrd"`
+	} `xml:"NtryDtls>TxDtls"`
+	AdditionalInfo string `xml:"AddtlNtryInf"`
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+func (d camtDate) day() (time.Time, bool) {
+	value := d.Date
+	if value == "" && len(d.DateTime) >= len(dayLayout) {
+		value = d.DateTime[:len(dayLayout)]
+	}
+	day, err := time.Parse(dayLayout, value)
+
+	return day, err == nil
+}
+
+// parseCAMT053 reads the booked entries of an ISO 20022 camt.053 statement.
+// Entries are matched by local element names, so any version of the schema
+// is accepted.
+func parseCAMT053(data []byte) ([]entities.BankTransaction, error) {
+	var document camtDocument
+	if err := xml.Unmarshal(data, &document); err != nil {
+		return nil, errors.Wrapf(ErrInvalidField, "invalid CAMT.053 document: %v", err)
+	}
+
+	var transactions []entities.BankTransaction
+	number := 0
+	for _, statement := range document.Statements {
+		for _, entry := range statement.Entries {
+			number++
+			status := strings.TrimSpace(entry.Status.Value)
+			if entry.Status.Code != "" {
+				status = strings.TrimSpace(entry.Status.Code)
+			}
+			if status != "" && status != "BOOK" {
+				continue
+			}
+
+			bookedAt, ok := entry.BookingDate.day()
+			if !ok {
+				if bookedAt, ok = entry.ValueDate.day(); !ok {
+					return nil, errors.Wrapf(ErrInvalidField, "entry %d: booking date is missing", number)
+				}
+			}
+
+			amount, err := parseMinorUnits(entry.Amount.Value)
+			if err != nil || amount < 0 {
+				return nil, errors.Wrapf(ErrInvalidField, "entry %d: invalid amount %q", number, entry.Amount.Value)
+			}
+			if strings.TrimSpace(entry.Indicator) == "DBIT" {
+				amount = -amount
+			}
+
+			id := entry.ServicerReference
+			if id == "" {
+				id = entry.Reference
+			}
+
+			transactions = append(transactions, entities.BankTransaction{
+				ID:           id,
+				BookedAt:     bookedAt,
+				Amount:       amount,
+				Currency:     strings.ToUpper(entry.Amount.Currency),
+				Counterparty: entry.counterparty(),
+			})
+		}
+	}
+
+	return transactions, nil
+}
+
+// counterparty is the creditor of the first transaction of the entry, or its
+// remittance information when the bank doesn't report the creditor.
+func (e camtEntry) counterparty() string {
+	for _, details := range e.Details {
+		if name := strings.TrimSpace(details.Creditor); name != "" {
+			return name
+		}
+		if name := strings.TrimSpace(details.CreditorParty); name != "" {
+			return name
+		}
+	}
+	for _, details := range e.Details {
+		if len(details.Remittance) > 0 {
+			return strings.TrimSpace(strings.Join(details.Remittance, " "))
+		}
+	}
+
+	return strings.TrimSpace(e.AdditionalInfo)
+}
+
+// parseMinorUnits reads a decimal amount such as "-9.99" or "1234,50" as
+// hundredths.
+func parseMinorUnits(value string) (int64, error) {
+	amount, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(value), ",", "."), 64)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return 0, errors.New("amount is not a number")
+	}
+
+	return int64(math.Round(amount * 100)), nil
+}
@@ -0,0 +1,35 @@
+package usecases
+
+import (
+	"context"
+	"subscription_service/internal/controllers/responses"
+	"subscription_service/internal/entities"
+	"time"
+)
+
+func toSubResponse(ctx context.Context, sub entities.Subscription) responses.SubResponse {
+	response := responses.SubResponse{
+		ID:            sub.ID.String(),
+		ServiceName:   sub.ServiceName,
+		Price:         currentPrice(sub),
+		Currency:      sub.Currency,
+		BillingPeriod: sub.BillingPeriod,
+		UserID:        sub.UserID.String(),
+		StartDate:     formatDate(ctx, sub.StartDate),
+		Status:        sub.Status(today()),
+		Version:       sub.Version,
+	}
+	if sub.EndDate != nil {
+		response.EndDate = formatDate(ctx, *sub.EndDate)
+	}
+	if sub.CancelledAt != nil {
+		response.CancelledAt = sub.CancelledAt.UTC().Format(time.RFC3339)
+		response.CancelReason = sub.CancelReason
+	}
+	if sub.TrialStartDate != nil && sub.TrialEndDate != nil {
+		response.TrialStart = formatDate(ctx, *sub.TrialStartDate)
+		response.TrialEnd = formatDate(ctx, *sub.TrialEndDate)
+	}
+
+	return response
+}
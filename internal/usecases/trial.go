@@ -0,0 +1,99 @@
+package usecases
+
+import (
+	"strconv"
+	"strings"
+	"subscription_service/internal/entities"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultTrialsWithin is how far ahead ending trials are looked up by default.
+const DefaultTrialsWithin = "7d"
+
+// maxTrialsWithinDays bounds the look-ahead window so that it stays a
+// meaningful date range.
+const maxTrialsWithinDays = 366
+
+// parseTrial reads the trial bounds of a request. A trial without a start
+// begins together with the subscription.
+func parseTrial(startDate time.Time, trialStart, trialEnd string) (*time.Time, *time.Time, error) {
+	if trialStart == "" && trialEnd == "" {
+		return nil, nil, nil
+	}
+	if trialEnd == "" {
+		return nil, nil, errors.Wrap(ErrInvalidPeriod, "trial_end_date is required with trial_start_date")
+	}
+
+	start := startDate
+	if trialStart != "" {
+		var err error
+		start, err = parseStartDate(trialStart)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to parse trial_start_date")
+		}
+	}
+
+	end, err := parseEndDate(trialEnd)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse trial_end_date")
+	}
+
+	return &start, &end, nil
+}
+
+// validateTrial checks that the trial lies within the subscription.
+func validateTrial(sub entities.Subscription) error {
+	if sub.TrialStartDate == nil || sub.TrialEndDate == nil {
+		return nil
+	}
+	if sub.TrialEndDate.Before(*sub.TrialStartDate) {
+		return errors.Wrap(ErrInvalidPeriod, "trial_end_date is before trial_start_date")
+	}
+	if sub.TrialStartDate.Before(sub.StartDate) {
+		return errors.Wrap(ErrInvalidPeriod, "trial_start_date is before start_date")
+	}
+	if sub.EndDate != nil && sub.TrialStartDate.After(*sub.EndDate) {
+		return errors.Wrap(ErrInvalidPeriod, "trial_start_date is after end_date")
+	}
+
+	return nil
+}
+
+// billingStart is the first paid day: the day after the trial when the
+// subscription starts with one, the start date otherwise. Weekly, quarterly
+// and yearly charges are counted from it.
+func billingStart(sub entities.Subscription) time.Time {
+	if sub.TrialStartDate != nil && sub.TrialEndDate != nil && !sub.TrialStartDate.After(sub.StartDate) {
+		return sub.TrialEndDate.AddDate(0, 0, 1)
+	}
+
+	return sub.StartDate
+}
+
+// parseWithin reads a look-ahead window given in days ("7d") or weeks ("2w").
+func parseWithin(within string) (int, error) {
+	unit := 1
+	value := within
+	switch {
+	case strings.HasSuffix(within, "d"):
+		value = strings.TrimSuffix(within, "d")
+	case strings.HasSuffix(within, "w"):
+		unit = 7
+		value = strings.TrimSuffix(within, "w")
+	default:
+		return 0, errors.Wrapf(ErrInvalidFilter, "expected within like 7d or 2w, got %q", within)
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		return 0, errors.Wrapf(ErrInvalidFilter, "expected within like 7d or 2w, got %q", within)
+	}
+	// n is checked on its own first so that n * unit can't overflow.
+	if n > maxTrialsWithinDays || n*unit > maxTrialsWithinDays {
+		return 0, errors.Wrapf(ErrInvalidFilter, "within can't be longer than %d days", maxTrialsWithinDays)
+	}
+
+	return n * unit, nil
+}
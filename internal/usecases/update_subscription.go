@@ -18,7 +18,7 @@ type updateSubUseCase struct {
 }
 
 type UpdateSubUseCase interface {
-	UpdateSubscription(ctx context.Context, subID string, req requests.SubRequest) (responses.SubResponse, error)
+	UpdateSubscription(ctx context.Context, subID string, version int, req requests.SubRequest) (responses.SubResponse, error)
 }
 
 func NewUpdateSubUseCase(subRepo UpdateSubRepository, logger logger.Logger) UpdateSubUseCase {
@@ -28,7 +28,7 @@ func NewUpdateSubUseCase(subRepo UpdateSubRepository, logger logger.Logger) Upda
 	}
 }
 
-func (u *updateSubUseCase) UpdateSubscription(ctx context.Context, subID string, req requests.SubRequest) (responses.SubResponse, error) {
+func (u *updateSubUseCase) UpdateSubscription(ctx context.Context, subID string, version int, req requests.SubRequest) (responses.SubResponse, error) {
 	subUUID, err := uuid.Parse(subID)
 	if err != nil {
 		u.logger.Error().Err(err).Msg("Invalid sub_id format")
@@ -41,29 +41,61 @@ func (u *updateSubUseCase) UpdateSubscription(ctx context.Context, subID string,
 		return responses.SubResponse{}, errors.Wrap(ErrInvalidUUID, "failed to parse user_id")
 	}
 
-	startDate, err := time.Parse("01-2006", req.StartDate)
+	startDate, err := parseStartDate(req.StartDate)
 	if err != nil {
 		u.logger.Error().Err(err).Msg("Invalid start_date format")
-		return responses.SubResponse{}, errors.Wrap(ErrInvalidDateFormat, "failed to parse start_date")
+		return responses.SubResponse{}, errors.Wrap(err, "failed to parse start_date")
+	}
+
+	currency, err := parseCurrency(req.Currency)
+	if err != nil {
+		u.logger.Error().Err(err).Msg("Invalid currency")
+		return responses.SubResponse{}, errors.Wrap(err, "failed to parse currency")
+	}
+
+	billingPeriod, err := parseBillingPeriod(req.BillingPeriod)
+	if err != nil {
+		u.logger.Error().Err(err).Msg("Invalid billing period")
+		return responses.SubResponse{}, errors.Wrap(err, "failed to parse billing_period")
 	}
 
 	var endDate *time.Time
 	if req.EndDate != "" {
-		ed, err := time.Parse("01-2006", req.EndDate)
+		ed, err := parseEndDate(req.EndDate)
 		if err != nil {
 			u.logger.Error().Err(err).Msg("Invalid end_date format")
-			return responses.SubResponse{}, errors.Wrap(ErrInvalidDateFormat, "failed to parse end_date")
+			return responses.SubResponse{}, errors.Wrap(err, "failed to parse end_date")
 		}
 		endDate = &ed
 	}
 
+	trialStart, trialEnd, err := parseTrial(startDate, req.TrialStart, req.TrialEnd)
+	if err != nil {
+		u.logger.Error().Err(err).Msg("Invalid trial")
+		return responses.SubResponse{}, err
+	}
+
 	sub := &entities.Subscription{
-		ID:          subUUID,
-		ServiceName: req.ServiceName,
-		Price:       req.Price,
-		UserID:      userID,
-		StartDate:   startDate,
-		EndDate:     endDate,
+		ID:             subUUID,
+		ServiceName:    req.ServiceName,
+		Price:          req.Price,
+		Currency:       currency,
+		BillingPeriod:  billingPeriod,
+		UserID:         userID,
+		StartDate:      startDate,
+		EndDate:        endDate,
+		TrialStartDate: trialStart,
+		TrialEndDate:   trialEnd,
+		Version:        version,
+	}
+
+	if err := validateTrial(*sub); err != nil {
+		u.logger.Error().Err(err).Msg("Invalid trial")
+		return responses.SubResponse{}, err
+	}
+
+	if err := keepStoredState(ctx, u.subRepo, sub, u.logger); err != nil {
+		return responses.SubResponse{}, err
 	}
 
 	if err := u.subRepo.Update(ctx, sub); err != nil {
@@ -71,17 +103,28 @@ func (u *updateSubUseCase) UpdateSubscription(ctx context.Context, subID string,
 		return responses.SubResponse{}, errors.Wrap(err, "failed to update subscription")
 	}
 
-	response := responses.SubResponse{
-		ID:          sub.ID.String(),
-		ServiceName: sub.ServiceName,
-		Price:       sub.Price,
-		UserID:      sub.UserID.String(),
-		StartDate:   sub.StartDate.Format("01-2006"),
+	return toSubResponse(ctx, *sub), nil
+}
+
+// keepStoredState makes a full update keep what the request can't set: the
+// initial price, the pauses and the cancellation. The price in the request
+// has to be the one effective today: a new price is added to the price
+// history instead, so that past months keep the price they were billed at.
+func keepStoredState(ctx context.Context, subRepo GetSubRepository, sub *entities.Subscription, log logger.Logger) error {
+	stored, err := subRepo.SelectByID(ctx, sub.ID.String())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get subscription")
+		return errors.Wrap(err, "failed to get subscription")
 	}
-	if sub.EndDate != nil {
-		endDateStr := sub.EndDate.Format("01-2006")
-		response.EndDate = endDateStr
+
+	if sub.Price != currentPrice(stored) {
+		log.Error().Msg("Price changed in place")
+		return errors.Wrap(ErrInvalidField, "price can't be changed in place, add it with POST /subscriptions/{sub_id}/prices")
 	}
 
-	return response, nil
+	sub.Price, sub.CurrentPrice = stored.Price, stored.CurrentPrice
+	sub.LastPause = stored.LastPause
+	sub.CancelledAt, sub.CancelReason = stored.CancelledAt, stored.CancelReason
+
+	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -21,6 +22,10 @@ func initUpdateSubTestMocks(t *testing.T) {
 	mockUpdateSubRepo = NewMockUpdateSubRepository(ctrl)
 }
 
+func storedSubscription(subID string, price int) entities.Subscription {
+	return entities.Subscription{ID: uuid.MustParse(subID), ServiceName: "Yandex Plus", Price: price, Version: 1}
+}
+
 func TestUpdateSubscription_Success(t *testing.T) {
 	initUpdateSubTestMocks(t)
 	ctx := context.Background()
@@ -32,11 +37,16 @@ func TestUpdateSubscription_Success(t *testing.T) {
 		StartDate:   "07-2025",
 	}
 
+	mockUpdateSubRepo.EXPECT().SelectByID(ctx, subID).Return(storedSubscription(subID, 400), nil)
 	sub := gomock.AssignableToTypeOf(&entities.Subscription{})
-	mockUpdateSubRepo.EXPECT().Update(ctx, sub).Return(nil)
+	mockUpdateSubRepo.EXPECT().Update(ctx, sub).DoAndReturn(func(_ context.Context, sub *entities.Subscription) error {
+		assert.Equal(t, 1, sub.Version)
+		sub.Version++
+		return nil
+	})
 
 	useCase := NewUpdateSubUseCase(mockUpdateSubRepo, mockLogger)
-	response, err := useCase.UpdateSubscription(ctx, subID, req)
+	response, err := useCase.UpdateSubscription(ctx, subID, 1, req)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
@@ -44,6 +54,28 @@ func TestUpdateSubscription_Success(t *testing.T) {
 	assert.Equal(t, req.Price, response.Price)
 	assert.Equal(t, req.UserID, response.UserID)
 	assert.Equal(t, req.StartDate, response.StartDate)
+	assert.Equal(t, 2, response.Version)
+}
+
+func TestUpdateSubscription_Failure_VersionMismatch(t *testing.T) {
+	initUpdateSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+	req := requests.SubRequest{
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		UserID:      uuid.New().String(),
+		StartDate:   "07-2025",
+	}
+
+	mockUpdateSubRepo.EXPECT().SelectByID(ctx, subID).Return(storedSubscription(subID, 400), nil)
+	mockUpdateSubRepo.EXPECT().Update(ctx, gomock.Any()).Return(ErrVersionMismatch)
+
+	useCase := NewUpdateSubUseCase(mockUpdateSubRepo, mockLogger)
+	_, err := useCase.UpdateSubscription(ctx, subID, 1, req)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrVersionMismatch)
 }
 
 func TestUpdateSubscription_Failure_InvalidSubID(t *testing.T) {
@@ -57,7 +89,7 @@ func TestUpdateSubscription_Failure_InvalidSubID(t *testing.T) {
 	}
 
 	useCase := NewUpdateSubUseCase(mockUpdateSubRepo, mockLogger)
-	_, err := useCase.UpdateSubscription(ctx, "invalid-uuid", req)
+	_, err := useCase.UpdateSubscription(ctx, "invalid-uuid", 1, req)
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, ErrInvalidUUID)
@@ -75,7 +107,7 @@ func TestUpdateSubscription_Failure_InvalidUserID(t *testing.T) {
 	}
 
 	useCase := NewUpdateSubUseCase(mockUpdateSubRepo, mockLogger)
-	_, err := useCase.UpdateSubscription(ctx, subID, req)
+	_, err := useCase.UpdateSubscription(ctx, subID, 1, req)
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, ErrInvalidUUID)
@@ -93,7 +125,7 @@ func TestUpdateSubscription_Failure_InvalidStartDate(t *testing.T) {
 	}
 
 	useCase := NewUpdateSubUseCase(mockUpdateSubRepo, mockLogger)
-	_, err := useCase.UpdateSubscription(ctx, subID, req)
+	_, err := useCase.UpdateSubscription(ctx, subID, 1, req)
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, ErrInvalidDateFormat)
@@ -110,10 +142,11 @@ func TestUpdateSubscription_Failure_NotFound(t *testing.T) {
 		StartDate:   "07-2025",
 	}
 
+	mockUpdateSubRepo.EXPECT().SelectByID(ctx, subID).Return(storedSubscription(subID, 400), nil)
 	mockUpdateSubRepo.EXPECT().Update(ctx, gomock.Any()).Return(ErrEntityNotFound)
 
 	useCase := NewUpdateSubUseCase(mockUpdateSubRepo, mockLogger)
-	_, err := useCase.UpdateSubscription(ctx, subID, req)
+	_, err := useCase.UpdateSubscription(ctx, subID, 1, req)
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, ErrEntityNotFound)
@@ -131,11 +164,88 @@ func TestUpdateSubscription_Failure_DatabaseError(t *testing.T) {
 	}
 
 	expectedErr := errors.New("database error")
+	mockUpdateSubRepo.EXPECT().SelectByID(ctx, subID).Return(storedSubscription(subID, 400), nil)
 	mockUpdateSubRepo.EXPECT().Update(ctx, gomock.Any()).Return(expectedErr)
 
 	useCase := NewUpdateSubUseCase(mockUpdateSubRepo, mockLogger)
-	_, err := useCase.UpdateSubscription(ctx, subID, req)
+	_, err := useCase.UpdateSubscription(ctx, subID, 1, req)
 
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, expectedErr)
 }
+
+func TestUpdateSubscription_Success_KeepsInitialPrice(t *testing.T) {
+	initUpdateSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+	req := requests.SubRequest{
+		ServiceName: "Yandex Plus",
+		Price:       500,
+		UserID:      uuid.New().String(),
+		StartDate:   "07-2025",
+	}
+	stored := storedSubscription(subID, 400)
+	stored.CurrentPrice = 500
+
+	mockUpdateSubRepo.EXPECT().SelectByID(ctx, subID).Return(stored, nil)
+	mockUpdateSubRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, sub *entities.Subscription) error {
+		assert.Equal(t, 400, sub.Price)
+		sub.Version++
+		return nil
+	})
+
+	useCase := NewUpdateSubUseCase(mockUpdateSubRepo, mockLogger)
+	response, err := useCase.UpdateSubscription(ctx, subID, 1, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 500, response.Price)
+}
+
+func TestUpdateSubscription_Success_KeepsPauseAndCancellation(t *testing.T) {
+	initUpdateSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+	req := requests.SubRequest{
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		UserID:      uuid.New().String(),
+		StartDate:   "07-2025",
+		EndDate:     "12-2099",
+	}
+	pausedFrom, _ := time.Parse("2006-01-02", "2025-08-01")
+	cancelledAt, _ := time.Parse(time.RFC3339, "2025-09-15T10:00:00Z")
+	stored := storedSubscription(subID, 400)
+	stored.LastPause = &entities.SubscriptionPause{SubscriptionID: stored.ID, PausedFrom: pausedFrom}
+	stored.CancelledAt = &cancelledAt
+	stored.CancelReason = "слишком дорого"
+
+	mockUpdateSubRepo.EXPECT().SelectByID(ctx, subID).Return(stored, nil)
+	mockUpdateSubRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+
+	useCase := NewUpdateSubUseCase(mockUpdateSubRepo, mockLogger)
+	response, err := useCase.UpdateSubscription(ctx, subID, 1, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entities.StatusPaused, response.Status)
+	assert.NotEmpty(t, response.CancelledAt)
+	assert.Equal(t, "слишком дорого", response.CancelReason)
+}
+
+func TestUpdateSubscription_Failure_PriceChangedInPlace(t *testing.T) {
+	initUpdateSubTestMocks(t)
+	ctx := context.Background()
+	subID := uuid.New().String()
+	req := requests.SubRequest{
+		ServiceName: "Yandex Plus",
+		Price:       500,
+		UserID:      uuid.New().String(),
+		StartDate:   "07-2025",
+	}
+
+	mockUpdateSubRepo.EXPECT().SelectByID(ctx, subID).Return(storedSubscription(subID, 400), nil)
+
+	useCase := NewUpdateSubUseCase(mockUpdateSubRepo, mockLogger)
+	_, err := useCase.UpdateSubscription(ctx, subID, 1, req)
+
+	assert.ErrorIs(t, err, ErrInvalidField)
+}